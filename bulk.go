@@ -8,6 +8,7 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"net/http"
 	"net/url"
 
 	"golang.org/x/net/context"
@@ -37,6 +38,7 @@ type BulkService struct {
 	refresh             string
 	routing             string
 	waitForActiveShards string
+	requireAlias        *bool
 	pretty              bool
 
 	// estimated bulk size in bytes, up to the request index sizeInBytesCursor
@@ -90,18 +92,30 @@ func (s *BulkService) Refresh(refresh string) *BulkService {
 	return s
 }
 
-// Routing specifies the routing value.
+// Routing specifies the default routing value for all bulk requests.
+// Requests that specify their own routing value are not affected.
 func (s *BulkService) Routing(routing string) *BulkService {
 	s.routing = routing
 	return s
 }
 
-// Pipeline specifies the pipeline id to preprocess incoming documents with.
+// Pipeline specifies the default pipeline id to preprocess incoming
+// documents with. Elasticsearch uses the pipeline of an individual
+// request instead, if it specifies one.
 func (s *BulkService) Pipeline(pipeline string) *BulkService {
 	s.pipeline = pipeline
 	return s
 }
 
+// RequireAlias specifies whether the index of every request must be
+// an alias, which prevents accidentally creating indices.
+// Index and update requests can override this individually,
+// see BulkIndexRequest.RequireAlias and BulkUpdateRequest.RequireAlias.
+func (s *BulkService) RequireAlias(requireAlias bool) *BulkService {
+	s.requireAlias = &requireAlias
+	return s
+}
+
 // WaitForActiveShards sets the number of shard copies that must be active
 // before proceeding with the bulk operation. Defaults to 1, meaning the
 // primary shard only. Set to `all` for all shard copies, otherwise set to
@@ -176,29 +190,16 @@ func (s *BulkService) bodyAsString() (string, error) {
 	return buf.String(), nil
 }
 
-// Do sends the batched requests to Elasticsearch. Note that, when successful,
-// you can reuse the BulkService for the next batch as the list of bulk
-// requests is cleared on success.
-func (s *BulkService) Do(ctx context.Context) (*BulkResponse, error) {
-	// No actions?
-	if s.NumberOfActions() == 0 {
-		return nil, errors.New("elastic: No bulk actions to commit")
-	}
-
-	// Get body
-	body, err := s.bodyAsString()
-	if err != nil {
-		return nil, err
-	}
-
-	// Build url
+// buildURL builds the URL for the operation.
+func (s *BulkService) buildURL() (string, url.Values, error) {
+	// Build URL
 	path := "/"
 	if len(s.index) > 0 {
 		index, err := uritemplates.Expand("{index}", map[string]string{
 			"index": s.index,
 		})
 		if err != nil {
-			return nil, err
+			return "", url.Values{}, err
 		}
 		path += index + "/"
 	}
@@ -207,14 +208,14 @@ func (s *BulkService) Do(ctx context.Context) (*BulkResponse, error) {
 			"type": s.typ,
 		})
 		if err != nil {
-			return nil, err
+			return "", url.Values{}, err
 		}
 		path += typ + "/"
 	}
 	path += "_bulk"
 
-	// Parameters
-	params := make(url.Values)
+	// Add query string parameters
+	params := url.Values{}
 	if s.pretty {
 		params.Set("pretty", fmt.Sprintf("%v", s.pretty))
 	}
@@ -233,6 +234,42 @@ func (s *BulkService) Do(ctx context.Context) (*BulkResponse, error) {
 	if s.waitForActiveShards != "" {
 		params.Set("wait_for_active_shards", s.waitForActiveShards)
 	}
+	if s.requireAlias != nil {
+		params.Set("require_alias", fmt.Sprintf("%v", *s.requireAlias))
+	}
+	return path, params, nil
+}
+
+// Validate checks if the operation is valid.
+func (s *BulkService) Validate() error {
+	return validateRefresh(s.refresh)
+}
+
+// Do sends the batched requests to Elasticsearch. Note that, when successful,
+// you can reuse the BulkService for the next batch as the list of bulk
+// requests is cleared on success.
+func (s *BulkService) Do(ctx context.Context) (*BulkResponse, error) {
+	// No actions?
+	if s.NumberOfActions() == 0 {
+		return nil, errors.New("elastic: No bulk actions to commit")
+	}
+
+	// Check pre-conditions
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+
+	// Get body
+	body, err := s.bodyAsString()
+	if err != nil {
+		return nil, err
+	}
+
+	// Get URL for request
+	path, params, err := s.buildURL()
+	if err != nil {
+		return nil, err
+	}
 
 	// Get response
 	res, err := s.client.PerformRequest(ctx, "POST", path, params, body)
@@ -363,7 +400,8 @@ func (r *BulkResponse) ById(id string) []*BulkResponseItem {
 }
 
 // Failed returns those items of a bulk response that have errors,
-// i.e. those that don't have a status code between 200 and 299.
+// i.e. those that have error details or don't have a status code
+// between 200 and 299, e.g. 409 (version conflict) or 429 (rejected).
 func (r *BulkResponse) Failed() []*BulkResponseItem {
 	if r.Items == nil {
 		return nil
@@ -371,7 +409,7 @@ func (r *BulkResponse) Failed() []*BulkResponseItem {
 	var errors []*BulkResponseItem
 	for _, item := range r.Items {
 		for _, result := range item {
-			if !(result.Status >= 200 && result.Status <= 299) {
+			if result.failed() {
 				errors = append(errors, result)
 			}
 		}
@@ -380,7 +418,7 @@ func (r *BulkResponse) Failed() []*BulkResponseItem {
 }
 
 // Succeeded returns those items of a bulk response that have no errors,
-// i.e. those have a status code between 200 and 299.
+// i.e. those have no error details and a status code between 200 and 299.
 func (r *BulkResponse) Succeeded() []*BulkResponseItem {
 	if r.Items == nil {
 		return nil
@@ -388,10 +426,51 @@ func (r *BulkResponse) Succeeded() []*BulkResponseItem {
 	var succeeded []*BulkResponseItem
 	for _, item := range r.Items {
 		for _, result := range item {
-			if result.Status >= 200 && result.Status <= 299 {
+			if !result.failed() {
 				succeeded = append(succeeded, result)
 			}
 		}
 	}
 	return succeeded
 }
+
+// CountByStatus returns the number of items of a bulk response by
+// HTTP status code, regardless of the action ("index", "delete" etc.).
+func (r *BulkResponse) CountByStatus() map[int]int {
+	counts := make(map[int]int)
+	for _, item := range r.Items {
+		for _, result := range item {
+			counts[result.Status]++
+		}
+	}
+	return counts
+}
+
+// RetryableItems returns those of the given requests that failed with
+// a status code that is worth retrying, i.e. 429 (Too Many Requests)
+// or 503 (Service Unavailable).
+//
+// Elasticsearch returns the items of a bulk response in the same order
+// as the requests of the bulk request. So requests must be the exact
+// list of requests sent in the bulk request that returned r, e.g.
+// as passed to BulkService.Add. Requests without a corresponding item
+// are not returned.
+func (r *BulkResponse) RetryableItems(requests []BulkableRequest) []BulkableRequest {
+	var retry []BulkableRequest
+	for i, item := range r.Items {
+		if i >= len(requests) {
+			break
+		}
+		for _, result := range item {
+			if result.Status == http.StatusTooManyRequests || result.Status == http.StatusServiceUnavailable {
+				retry = append(retry, requests[i])
+			}
+		}
+	}
+	return retry
+}
+
+// failed returns true if the bulk request of this item failed.
+func (item *BulkResponseItem) failed() bool {
+	return item.Error != nil || item.Status < 200 || item.Status > 299
+}
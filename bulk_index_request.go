@@ -28,6 +28,7 @@ type BulkIndexRequest struct {
 	pipeline        string
 	retryOnConflict *int
 	ttl             string
+	requireAlias    *bool
 
 	source []string
 }
@@ -134,6 +135,14 @@ func (r *BulkIndexRequest) Pipeline(pipeline string) *BulkIndexRequest {
 	return r
 }
 
+// RequireAlias specifies whether the index must be an alias. It overrides
+// the setting of BulkService.RequireAlias for this request.
+func (r *BulkIndexRequest) RequireAlias(requireAlias bool) *BulkIndexRequest {
+	r.requireAlias = &requireAlias
+	r.source = nil
+	return r
+}
+
 // String returns the on-wire representation of the index request,
 // concatenated as a single string.
 func (r *BulkIndexRequest) String() string {
@@ -191,6 +200,9 @@ func (r *BulkIndexRequest) Source() ([]string, error) {
 	if r.pipeline != "" {
 		indexCommand["pipeline"] = r.pipeline
 	}
+	if r.requireAlias != nil {
+		indexCommand["require_alias"] = *r.requireAlias
+	}
 	command[r.opType] = indexCommand
 	line, err := json.Marshal(command)
 	if err != nil {
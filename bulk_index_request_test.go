@@ -68,6 +68,15 @@ func TestBulkIndexRequestSerialization(t *testing.T) {
 				`{"user":"olivere","message":"","retweets":0,"created":"2014-01-18T23:59:58Z"}`,
 			},
 		},
+		// #6
+		{
+			Request: NewBulkIndexRequest().OpType("create").Index("alias1").Type("tweet").Id("1").RequireAlias(true).
+				Doc(tweet{User: "olivere", Created: time.Date(2014, 1, 18, 23, 59, 58, 0, time.UTC)}),
+			Expected: []string{
+				`{"create":{"_id":"1","_index":"alias1","_type":"tweet","require_alias":true}}`,
+				`{"user":"olivere","message":"","retweets":0,"created":"2014-01-18T23:59:58Z"}`,
+			},
+		},
 	}
 
 	for i, test := range tests {
@@ -15,3 +15,34 @@ type BulkableRequest interface {
 	fmt.Stringer
 	Source() ([]string, error)
 }
+
+// -- Shortcuts for common bulkable requests --
+
+// IndexDoc returns a BulkIndexRequest that indexes doc with the given
+// index and id. It is a shortcut for
+// NewBulkIndexRequest().Index(index).Id(id).Doc(doc).
+func IndexDoc(index, id string, doc interface{}) *BulkIndexRequest {
+	return NewBulkIndexRequest().Index(index).Id(id).Doc(doc)
+}
+
+// DeleteDoc returns a BulkDeleteRequest that deletes the document with
+// the given index and id. It is a shortcut for
+// NewBulkDeleteRequest().Index(index).Id(id).
+func DeleteDoc(index, id string) *BulkDeleteRequest {
+	return NewBulkDeleteRequest().Index(index).Id(id)
+}
+
+// UpdateDoc returns a BulkUpdateRequest that applies the partial document
+// to the document with the given index and id. It is a shortcut for
+// NewBulkUpdateRequest().Index(index).Id(id).Doc(partial).
+func UpdateDoc(index, id string, partial interface{}) *BulkUpdateRequest {
+	return NewBulkUpdateRequest().Index(index).Id(id).Doc(partial)
+}
+
+// UpsertDoc returns a BulkUpdateRequest that updates the document with
+// the given index and id, or creates it if it does not exist yet.
+// It is a shortcut for
+// NewBulkUpdateRequest().Index(index).Id(id).Doc(doc).DocAsUpsert(true).
+func UpsertDoc(index, id string, doc interface{}) *BulkUpdateRequest {
+	return NewBulkUpdateRequest().Index(index).Id(id).Doc(doc).DocAsUpsert(true)
+}
@@ -0,0 +1,60 @@
+// Copyright 2012-present Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestBulkRequestShortcuts(t *testing.T) {
+	doc := tweet{User: "olivere", Message: "Welcome to Golang and Elasticsearch."}
+	partial := map[string]interface{}{"retweets": 42}
+
+	tests := []struct {
+		Shortcut BulkableRequest
+		Verbose  BulkableRequest
+	}{
+		// #0
+		{
+			Shortcut: IndexDoc("index1", "1", doc),
+			Verbose:  NewBulkIndexRequest().Index("index1").Id("1").Doc(doc),
+		},
+		// #1
+		{
+			Shortcut: IndexDoc("index1", "1", doc).Type("tweet"),
+			Verbose:  NewBulkIndexRequest().Index("index1").Type("tweet").Id("1").Doc(doc),
+		},
+		// #2
+		{
+			Shortcut: DeleteDoc("index1", "1"),
+			Verbose:  NewBulkDeleteRequest().Index("index1").Id("1"),
+		},
+		// #3
+		{
+			Shortcut: UpdateDoc("index1", "1", partial),
+			Verbose:  NewBulkUpdateRequest().Index("index1").Id("1").Doc(partial),
+		},
+		// #4
+		{
+			Shortcut: UpsertDoc("index1", "1", doc),
+			Verbose:  NewBulkUpdateRequest().Index("index1").Id("1").Doc(doc).DocAsUpsert(true),
+		},
+	}
+
+	for i, test := range tests {
+		got, err := test.Shortcut.Source()
+		if err != nil {
+			t.Fatalf("case #%d: %v", i, err)
+		}
+		expected, err := test.Verbose.Source()
+		if err != nil {
+			t.Fatalf("case #%d: %v", i, err)
+		}
+		if !reflect.DeepEqual(got, expected) {
+			t.Errorf("case #%d: expected %v; got: %v", i, expected, got)
+		}
+	}
+}
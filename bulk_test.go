@@ -506,3 +506,203 @@ func BenchmarkBulkEstimatedSizeInBytesWith100Requests(b *testing.B) {
 	b.ReportAllocs()
 	benchmarkBulkEstimatedSizeInBytes = result // ensure the compiler doesn't optimize
 }
+
+func TestBulkBuildURL(t *testing.T) {
+	client := setupTestClient(t)
+
+	tests := []struct {
+		Service       *BulkService
+		ExpectedPath  string
+		ExpectedQuery string
+	}{
+		{
+			client.Bulk(),
+			"/_bulk",
+			"",
+		},
+		{
+			client.Bulk().Index("twitter").Type("tweet"),
+			"/twitter/tweet/_bulk",
+			"",
+		},
+		{
+			client.Bulk().Pipeline("my_pipeline").Routing("user-1"),
+			"/_bulk",
+			"pipeline=my_pipeline&routing=user-1",
+		},
+		{
+			client.Bulk().RequireAlias(true).WaitForActiveShards("all"),
+			"/_bulk",
+			"require_alias=true&wait_for_active_shards=all",
+		},
+	}
+
+	for i, test := range tests {
+		path, params, err := test.Service.buildURL()
+		if err != nil {
+			t.Fatalf("case #%d: %v", i+1, err)
+		}
+		if want, have := test.ExpectedPath, path; want != have {
+			t.Errorf("case #%d: expected path %q; got: %q", i+1, want, have)
+		}
+		if want, have := test.ExpectedQuery, params.Encode(); want != have {
+			t.Errorf("case #%d: expected query %q; got: %q", i+1, want, have)
+		}
+	}
+}
+
+func TestBulkServiceDefaultsDoNotOverrideRequests(t *testing.T) {
+	client := setupTestClient(t)
+
+	// The service-level pipeline and routing are sent as query string
+	// parameters. Elasticsearch applies them only to requests that do
+	// not specify their own, so per-request values stay in the body.
+	s := client.Bulk().Pipeline("default_pipeline").Routing("default_routing").
+		Add(NewBulkIndexRequest().Index("twitter").Type("tweet").Id("1").
+			Pipeline("my_pipeline").Routing("my_routing").Doc(map[string]interface{}{"user": "olivere"})).
+		Add(NewBulkIndexRequest().Index("twitter").Type("tweet").Id("2").
+			Doc(map[string]interface{}{"user": "sandrae"}))
+	body, err := s.bodyAsString()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := `{"index":{"_id":"1","_index":"twitter","_routing":"my_routing","_type":"tweet","pipeline":"my_pipeline"}}
+{"user":"olivere"}
+{"index":{"_id":"2","_index":"twitter","_type":"tweet"}}
+{"user":"sandrae"}
+`
+	if body != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, body)
+	}
+	_, params, err := s.buildURL()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, have := "pipeline=default_pipeline&routing=default_routing", params.Encode(); want != have {
+		t.Errorf("expected query %q; got: %q", want, have)
+	}
+}
+
+func TestBulkResponseByStatus(t *testing.T) {
+	body := `{
+		"took": 3,
+		"errors": true,
+		"items": [
+			{"index": {"_index": "twitter", "_type": "tweet", "_id": "1", "_version": 1, "status": 201}},
+			{"create": {"_index": "twitter", "_type": "tweet", "_id": "2", "status": 409,
+				"error": {"type": "version_conflict_engine_exception", "reason": "[tweet][2]: version conflict, document already exists"}}},
+			{"update": {"_index": "twitter", "_type": "tweet", "_id": "3", "status": 429,
+				"error": {"type": "es_rejected_execution_exception", "reason": "rejected execution"}}},
+			{"delete": {"_index": "twitter", "_type": "tweet", "_id": "4", "_version": 2, "status": 201}}
+		]
+	}`
+	var res BulkResponse
+	if err := json.Unmarshal([]byte(body), &res); err != nil {
+		t.Fatal(err)
+	}
+
+	failed := res.Failed()
+	if want, have := 2, len(failed); want != have {
+		t.Fatalf("expected %d failed items; got: %d", want, have)
+	}
+	if want, have := "2", failed[0].Id; want != have {
+		t.Errorf("expected failed item %q; got: %q", want, have)
+	}
+	if want, have := "3", failed[1].Id; want != have {
+		t.Errorf("expected failed item %q; got: %q", want, have)
+	}
+	if failed[1].Error == nil || failed[1].Error.Type != "es_rejected_execution_exception" {
+		t.Errorf("expected error details on failed item; got: %+v", failed[1].Error)
+	}
+
+	succeeded := res.Succeeded()
+	if want, have := 2, len(succeeded); want != have {
+		t.Fatalf("expected %d succeeded items; got: %d", want, have)
+	}
+	if want, have := "1", succeeded[0].Id; want != have {
+		t.Errorf("expected succeeded item %q; got: %q", want, have)
+	}
+	if want, have := "4", succeeded[1].Id; want != have {
+		t.Errorf("expected succeeded item %q; got: %q", want, have)
+	}
+
+	counts := res.CountByStatus()
+	expected := map[int]int{201: 2, 409: 1, 429: 1}
+	if want, have := len(expected), len(counts); want != have {
+		t.Fatalf("expected %d status codes; got: %d (%v)", want, have, counts)
+	}
+	for status, want := range expected {
+		if have := counts[status]; want != have {
+			t.Errorf("expected %d items with status %d; got: %d", want, status, have)
+		}
+	}
+
+	// An item with error details fails even with a successful status code
+	item := &BulkResponseItem{Status: 200, Error: &ErrorDetails{Type: "some_exception"}}
+	if !item.failed() {
+		t.Error("expected item with error details to be failed")
+	}
+}
+
+func TestBulkResponseRetryableItems(t *testing.T) {
+	r1 := NewBulkIndexRequest().Index("twitter").Type("tweet").Id("1").Doc(map[string]interface{}{"user": "olivere"})
+	r2 := NewBulkIndexRequest().Index("twitter").Type("tweet").Id("2").Doc(map[string]interface{}{"user": "sandrae"})
+	r3 := NewBulkDeleteRequest().Index("twitter").Type("tweet").Id("3")
+	requests := []BulkableRequest{r1, r2, r3}
+
+	body := `{
+		"took": 3,
+		"errors": true,
+		"items": [
+			{"index": {"_index": "twitter", "_type": "tweet", "_id": "1", "status": 201}},
+			{"index": {"_index": "twitter", "_type": "tweet", "_id": "2", "status": 429,
+				"error": {"type": "es_rejected_execution_exception", "reason": "rejected execution"}}},
+			{"delete": {"_index": "twitter", "_type": "tweet", "_id": "3", "status": 404, "found": false}}
+		]
+	}`
+	var res BulkResponse
+	if err := json.Unmarshal([]byte(body), &res); err != nil {
+		t.Fatal(err)
+	}
+
+	retry := res.RetryableItems(requests)
+	if want, have := 1, len(retry); want != have {
+		t.Fatalf("expected %d retryable requests; got: %d", want, have)
+	}
+	if retry[0] != r2 {
+		t.Errorf("expected request %v to be retried; got: %v", r2, retry[0])
+	}
+
+	// Fewer requests than items must not panic
+	if want, have := 0, len(res.RetryableItems(requests[:1])); want != have {
+		t.Errorf("expected %d retryable requests; got: %d", want, have)
+	}
+}
+
+func TestBulkRefresh(t *testing.T) {
+	client := setupTestClient(t)
+
+	svc := client.Bulk().Refresh("wait_for")
+	if err := svc.Validate(); err != nil {
+		t.Fatalf("expected Bulk to accept refresh=wait_for; got: %v", err)
+	}
+	_, params, err := svc.buildURL()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, have := "wait_for", params.Get("refresh"); want != have {
+		t.Errorf("expected refresh=%q; got: %q", want, have)
+	}
+
+	tweet := tweet{User: "olivere", Message: "Welcome to Golang and Elasticsearch."}
+	res, err := client.Bulk().
+		Add(NewBulkIndexRequest().Index(testIndexName).Type("tweet").Id("1").Doc(tweet)).
+		Refresh("yes").
+		Do(context.TODO())
+	if err == nil {
+		t.Fatalf("expected Bulk to fail with invalid refresh value")
+	}
+	if res != nil {
+		t.Fatalf("expected result to be == nil; got: %v", res)
+	}
+}
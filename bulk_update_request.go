@@ -26,6 +26,7 @@ type BulkUpdateRequest struct {
 	version         int64  // default is MATCH_ANY
 	versionType     string // default is "internal"
 	retryOnConflict *int
+	requireAlias    *bool
 	upsert          interface{}
 	docAsUpsert     *bool
 	detectNoop      *bool
@@ -93,6 +94,14 @@ func (r *BulkUpdateRequest) RetryOnConflict(retryOnConflict int) *BulkUpdateRequ
 	return r
 }
 
+// RequireAlias specifies whether the index must be an alias. It overrides
+// the setting of BulkService.RequireAlias for this request.
+func (r *BulkUpdateRequest) RequireAlias(requireAlias bool) *BulkUpdateRequest {
+	r.requireAlias = &requireAlias
+	r.source = nil
+	return r
+}
+
 // Version indicates the version of the document as part of an optimistic
 // concurrency model.
 func (r *BulkUpdateRequest) Version(version int64) *BulkUpdateRequest {
@@ -217,6 +226,9 @@ func (r BulkUpdateRequest) Source() ([]string, error) {
 	if r.retryOnConflict != nil {
 		updateCommand["_retry_on_conflict"] = *r.retryOnConflict
 	}
+	if r.requireAlias != nil {
+		updateCommand["require_alias"] = *r.requireAlias
+	}
 	command["update"] = updateCommand
 	line, err := json.Marshal(command)
 	if err != nil {
@@ -67,6 +67,18 @@ func TestBulkUpdateRequestSerialization(t *testing.T) {
 				`{"detect_noop":true,"doc":{"counter":42}}`,
 			},
 		},
+		// #4
+		{
+			Request: NewBulkUpdateRequest().Index("alias1").Type("tweet").Id("1").RequireAlias(false).Doc(struct {
+				Counter int64 `json:"counter"`
+			}{
+				Counter: 42,
+			}),
+			Expected: []string{
+				`{"update":{"_id":"1","_index":"alias1","_type":"tweet","require_alias":false}}`,
+				`{"doc":{"counter":42}}`,
+			},
+		},
 	}
 
 	for i, test := range tests {
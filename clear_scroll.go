@@ -7,7 +7,6 @@ package elastic
 import (
 	"fmt"
 	"net/url"
-	"strings"
 
 	"golang.org/x/net/context"
 )
@@ -31,7 +30,8 @@ func NewClearScrollService(client *Client) *ClearScrollService {
 }
 
 // ScrollId is a list of scroll IDs to clear.
-// Use _all to clear all search contexts.
+// Use _all to clear all search contexts. The IDs are sent in the
+// request body, so it is safe to clear a large number of them at once.
 func (s *ClearScrollService) ScrollId(scrollIds ...string) *ClearScrollService {
 	s.scrollId = append(s.scrollId, scrollIds...)
 	return s
@@ -68,6 +68,15 @@ func (s *ClearScrollService) Validate() error {
 	return nil
 }
 
+// getBody returns the body of the request.
+func (s *ClearScrollService) getBody() interface{} {
+	return struct {
+		ScrollId []string `json:"scroll_id"`
+	}{
+		ScrollId: s.scrollId,
+	}
+}
+
 // Do executes the operation.
 func (s *ClearScrollService) Do(ctx context.Context) (*ClearScrollResponse, error) {
 	// Check pre-conditions
@@ -81,11 +90,8 @@ func (s *ClearScrollService) Do(ctx context.Context) (*ClearScrollResponse, erro
 		return nil, err
 	}
 
-	// Setup HTTP request body
-	body := strings.Join(s.scrollId, ",")
-
 	// Get HTTP response
-	res, err := s.client.PerformRequest(ctx, "DELETE", path, params, body)
+	res, err := s.client.PerformRequest(ctx, "DELETE", path, params, s.getBody())
 	if err != nil {
 		return nil, err
 	}
@@ -100,4 +106,6 @@ func (s *ClearScrollService) Do(ctx context.Context) (*ClearScrollResponse, erro
 
 // ClearScrollResponse is the response of ClearScrollService.Do.
 type ClearScrollResponse struct {
+	Succeeded bool `json:"succeeded,omitempty"`
+	NumFreed  int  `json:"num_freed,omitempty"`
 }
@@ -5,7 +5,10 @@
 package elastic
 
 import (
-	_ "net/http"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"golang.org/x/net/context"
@@ -86,3 +89,57 @@ func TestClearScrollValidate(t *testing.T) {
 		t.Fatalf("expected result to be nil; got: %v", res)
 	}
 }
+
+func TestClearScrollManyIdsInBody(t *testing.T) {
+	var scrollIds []string
+	for i := 0; i < 500; i++ {
+		scrollIds = append(scrollIds, fmt.Sprintf("DnF1ZXJ5VGhlbkZldGNo%03d", i))
+	}
+
+	var received []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "DELETE" {
+			http.Error(w, "unexpected method "+r.Method, http.StatusBadRequest)
+			return
+		}
+		if r.URL.Path != "/_search/scroll/" || r.URL.RawQuery != "" {
+			http.Error(w, "unexpected URL "+r.URL.String(), http.StatusBadRequest)
+			return
+		}
+		var body struct {
+			ScrollId []string `json:"scroll_id"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		received = body.ScrollId
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"succeeded":true,"num_freed":%d}`, len(body.ScrollId))
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(SetURL(ts.URL), SetSniff(false), SetHealthcheck(false), SetMaxRetries(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := client.ClearScroll().ScrollId(scrollIds...).Do(context.TODO())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, have := len(scrollIds), len(received); want != have {
+		t.Fatalf("expected %d scroll ids in body; got: %d", want, have)
+	}
+	for i := range scrollIds {
+		if scrollIds[i] != received[i] {
+			t.Fatalf("expected scroll id #%d = %q; got: %q", i, scrollIds[i], received[i])
+		}
+	}
+	if !res.Succeeded {
+		t.Errorf("expected succeeded = %v; got: %v", true, res.Succeeded)
+	}
+	if want, have := 500, res.NumFreed; want != have {
+		t.Errorf("expected num_freed = %d; got: %d", want, have)
+	}
+}
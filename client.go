@@ -97,6 +97,15 @@ var (
 	// ErrTimeout is raised when a request timed out, e.g. when WaitForStatus
 	// didn't return in time.
 	ErrTimeout = errors.New("timeout")
+
+	// ErrResponseTooLarge is raised when the body of a response exceeds
+	// the limit configured with SetMaxResponseSize.
+	ErrResponseTooLarge = errors.New("response too large")
+
+	// ErrNoSource is raised when unmarshaling the source of a search hit
+	// or document that has none, e.g. because it was disabled or excluded
+	// by source filtering.
+	ErrNoSource = errors.New("no source to unmarshal")
 )
 
 // ClientOptionFunc is a function that configures a Client.
@@ -136,6 +145,13 @@ type Client struct {
 	sendGetBodyAs             string        // override for when sending a GET with a body
 	requiredPlugins           []string      // list of required plugins
 	gzipEnabled               bool          // gzip compression enabled or disabled (default)
+
+	deprecationlog func(*http.Request, string) // callback for Warning headers, guarded by mu
+	retryStatuses  map[int]bool                // HTTP statuses to retry, guarded by mu; nil means default
+	maxRespSize    int64                       // max. size of a response body in bytes, guarded by mu; 0 means unlimited
+	requireProduct bool                        // require the X-Elastic-Product header at startup, guarded by mu
+	requireProdReq bool                        // require the X-Elastic-Product header in every response, guarded by mu
+	selector       ConnectionSelector          // picks the next connection, guarded by connsMu; nil means round-robin
 }
 
 // NewClient creates a new client to work with Elasticsearch.
@@ -489,6 +505,68 @@ func SetMaxRetries(maxRetries int) ClientOptionFunc {
 	}
 }
 
+// SetRetryStatuses specifies the HTTP status codes for which PerformRequest
+// retries a request, in addition to connection errors. Retries use the
+// same back-off and are limited by SetMaxRetries. Calling it without any
+// status codes disables retries on HTTP status codes altogether.
+// By default, only searches without a scroll are retried, on 503.
+func SetRetryStatuses(statuses ...int) ClientOptionFunc {
+	return func(c *Client) error {
+		c.retryStatuses = make(map[int]bool)
+		for _, status := range statuses {
+			c.retryStatuses[status] = true
+		}
+		return nil
+	}
+}
+
+// SetMaxResponseSize limits the size of response bodies to the given number
+// of bytes. Requests with a larger response fail with ErrResponseTooLarge.
+// It protects against e.g. misbehaving proxies streaming huge responses.
+// The default of 0 means that the size is unlimited.
+func SetMaxResponseSize(bytes int64) ClientOptionFunc {
+	return func(c *Client) error {
+		c.maxRespSize = bytes
+		return nil
+	}
+}
+
+// SetRequireProductHeader specifies whether the startup healthcheck
+// requires the X-Elastic-Product header with a value of "Elasticsearch",
+// as sent by Elasticsearch 7.14 and later. If enabled, NewClient fails
+// when the header is missing or wrong, e.g. when connecting to a different
+// service. The check is skipped if the healthcheck is disabled.
+// It is disabled by default.
+//
+// Use SetRequireProductHeaderOnEveryRequest to check every response.
+func SetRequireProductHeader(enabled bool) ClientOptionFunc {
+	return func(c *Client) error {
+		c.requireProduct = enabled
+		return nil
+	}
+}
+
+// SetRequireProductHeaderOnEveryRequest specifies whether every successful
+// response must carry the X-Elastic-Product header with a value of
+// "Elasticsearch". If enabled, the startup healthcheck checks the header
+// as well, see SetRequireProductHeader. It is disabled by default.
+func SetRequireProductHeaderOnEveryRequest(enabled bool) ClientOptionFunc {
+	return func(c *Client) error {
+		c.requireProdReq = enabled
+		return nil
+	}
+}
+
+// SetConnectionSelector specifies the strategy to pick the connection
+// for the next request, e.g. NewLeastOutstandingSelector. By default,
+// the Client picks connections in round-robin order.
+func SetConnectionSelector(selector ConnectionSelector) ClientOptionFunc {
+	return func(c *Client) error {
+		c.selector = selector
+		return nil
+	}
+}
+
 // SetGzip enables or disables gzip compression (disabled by default).
 func SetGzip(enabled bool) ClientOptionFunc {
 	return func(c *Client) error {
@@ -549,6 +627,17 @@ func SetTraceLog(logger Logger) ClientOptionFunc {
 	}
 }
 
+// SetDeprecationLog sets a callback that is invoked for each Warning header
+// returned by Elasticsearch, e.g. when using deprecated features. If a
+// response contains several Warning headers, the callback is invoked once
+// per header value. It is nil by default.
+func SetDeprecationLog(fn func(req *http.Request, warning string)) ClientOptionFunc {
+	return func(c *Client) error {
+		c.deprecationlog = fn
+		return nil
+	}
+}
+
 // SetSendGetBodyAs specifies the HTTP method to use when sending a GET request
 // with a body. It is GET by default.
 func SetSendGetBodyAs(httpMethod string) ClientOptionFunc {
@@ -660,6 +749,17 @@ func (c *Client) tracef(format string, args ...interface{}) {
 	}
 }
 
+// logDeprecations passes the Warning headers of the given HTTP response
+// to the deprecation log callback.
+func (c *Client) logDeprecations(fn func(*http.Request, string), req *http.Request, res *http.Response) {
+	if fn == nil {
+		return
+	}
+	for _, warning := range res.Header["Warning"] {
+		fn(req, warning)
+	}
+}
+
 // dumpRequest dumps the given HTTP request to the trace log.
 func (c *Client) dumpRequest(r *http.Request) {
 	if c.tracelog != nil {
@@ -960,6 +1060,7 @@ func (c *Client) startupHealthcheck(timeout time.Duration) error {
 	basicAuth := c.basicAuth
 	basicAuthUsername := c.basicAuthUsername
 	basicAuthPassword := c.basicAuthPassword
+	requireProduct := c.requireProduct || c.requireProdReq
 	c.mu.Unlock()
 
 	// If we don't get a connection after "timeout", we bail.
@@ -980,6 +1081,9 @@ func (c *Client) startupHealthcheck(timeout time.Duration) error {
 			}
 			res, err := cl.Do(req)
 			if err == nil && res != nil && res.StatusCode >= 200 && res.StatusCode < 300 {
+				if requireProduct {
+					return checkProductHeader(url, res)
+				}
 				return nil
 			}
 		}
@@ -993,25 +1097,42 @@ func (c *Client) startupHealthcheck(timeout time.Duration) error {
 
 // next returns the next available connection, or ErrNoClient.
 func (c *Client) next() (*conn, error) {
-	// We do round-robin here.
-	// TODO(oe) This should be a pluggable strategy, like the Selector in the official clients.
 	c.connsMu.Lock()
 	defer c.connsMu.Unlock()
 
-	i := 0
-	numConns := len(c.conns)
-	for {
-		i++
-		if i > numConns {
-			break // we visited all conns: they all seem to be dead
+	if c.selector != nil {
+		// Use the configured strategy
+		conns := make([]Connection, len(c.conns))
+		for i, cn := range c.conns {
+			conns[i] = cn
 		}
-		c.cindex++
-		if c.cindex >= numConns {
-			c.cindex = 0
+		selected, err := c.selector.Select(conns)
+		if err == nil {
+			if cn, ok := selected.(*conn); ok {
+				return cn, nil
+			}
+			return nil, fmt.Errorf("elastic: connection selector returned an unknown connection %v", selected)
 		}
-		conn := c.conns[c.cindex]
-		if !conn.IsDead() {
-			return conn, nil
+		if err != ErrNoClient {
+			return nil, err
+		}
+	} else {
+		// We do round-robin here.
+		i := 0
+		numConns := len(c.conns)
+		for {
+			i++
+			if i > numConns {
+				break // we visited all conns: they all seem to be dead
+			}
+			c.cindex++
+			if c.cindex >= numConns {
+				c.cindex = 0
+			}
+			conn := c.conns[c.cindex]
+			if !conn.IsDead() {
+				return conn, nil
+			}
 		}
 	}
 
@@ -1030,6 +1151,17 @@ func (c *Client) next() (*conn, error) {
 	return nil, ErrNoClient
 }
 
+// release notifies the connection selector, if any, that the request
+// on the given connection has finished.
+func (c *Client) release(conn *conn) {
+	c.connsMu.RLock()
+	selector := c.selector
+	c.connsMu.RUnlock()
+	if selector != nil {
+		selector.Release(conn)
+	}
+}
+
 // mustActiveConn returns nil if there is an active connection,
 // otherwise ErrNoClient is returned.
 func (c *Client) mustActiveConn() error {
@@ -1051,6 +1183,14 @@ func (c *Client) mustActiveConn() error {
 // This is necessary for services that expect e.g. HTTP status 404 as a
 // valid outcome (Exists, IndicesExists, IndicesTypeExists).
 func (c *Client) PerformRequest(ctx context.Context, method, path string, params url.Values, body interface{}, ignoreErrors ...int) (*Response, error) {
+	return c.performRequest(ctx, method, path, params, body, nil, ignoreErrors...)
+}
+
+// performRequest does a HTTP request to Elasticsearch, like PerformRequest.
+// If result is not nil, the body of a successful response is decoded into
+// result. If the configured decoder is a ReaderDecoder, it reads directly
+// from the HTTP response body and the Body of the returned Response is nil.
+func (c *Client) performRequest(ctx context.Context, method, path string, params url.Values, body interface{}, result interface{}, ignoreErrors ...int) (*Response, error) {
 	start := time.Now().UTC()
 
 	c.mu.RLock()
@@ -1061,6 +1201,10 @@ func (c *Client) PerformRequest(ctx context.Context, method, path string, params
 	basicAuthPassword := c.basicAuthPassword
 	sendGetBodyAs := c.sendGetBodyAs
 	gzipEnabled := c.gzipEnabled
+	deprecationlog := c.deprecationlog
+	retryStatuses := c.retryStatuses
+	maxRespSize := c.maxRespSize
+	requireProduct := c.requireProdReq
 	c.mu.RUnlock()
 
 	var err error
@@ -1108,6 +1252,7 @@ func (c *Client) PerformRequest(ctx context.Context, method, path string, params
 		req, err = NewRequest(method, conn.URL()+pathWithParams)
 		if err != nil {
 			c.errorf("elastic: cannot create request for %s %s: %v", strings.ToUpper(method), conn.URL()+pathWithParams, err)
+			c.release(conn)
 			return nil, err
 		}
 
@@ -1120,6 +1265,7 @@ func (c *Client) PerformRequest(ctx context.Context, method, path string, params
 			err = req.SetBody(body, gzipEnabled)
 			if err != nil {
 				c.errorf("elastic: couldn't set body %+v for request: %v", body, err)
+				c.release(conn)
 				return nil, err
 			}
 		}
@@ -1130,6 +1276,7 @@ func (c *Client) PerformRequest(ctx context.Context, method, path string, params
 		// Get response
 		res, err := ctxhttp.Do(ctx, c.c, (*http.Request)(req))
 		if err != nil {
+			c.release(conn)
 			retries--
 			if retries <= 0 {
 				c.errorf("elastic: %s is dead", conn.URL())
@@ -1141,9 +1288,45 @@ func (c *Client) PerformRequest(ctx context.Context, method, path string, params
 			retryWaitMsec += retryWaitMsec
 			continue // try again
 		}
+		// Retry on selected HTTP status codes, e.g. 503 Service Unavailable
+		// when the cluster is not ready yet. The body is encoded from scratch
+		// for every attempt, so the retry sends exactly the same body.
+		if shouldRetryStatus(retryStatuses, res.StatusCode, path, params) {
+			retries--
+			if retries > 0 {
+				if res.Body != nil {
+					res.Body.Close()
+				}
+				c.release(conn)
+				retried = true
+				time.Sleep(time.Duration(retryWaitMsec) * time.Millisecond)
+				retryWaitMsec += retryWaitMsec
+				continue // try again
+			}
+		}
 		if res.Body != nil {
 			defer res.Body.Close()
 		}
+		defer c.release(conn)
+
+		// Limit the size of the response body
+		if maxRespSize > 0 && res.Body != nil {
+			if res.ContentLength > maxRespSize {
+				return nil, ErrResponseTooLarge
+			}
+			res.Body = &limitedBody{rc: res.Body, n: maxRespSize}
+		}
+
+		// Report deprecation warnings
+		c.logDeprecations(deprecationlog, (*http.Request)(req), res)
+
+		// Check that we are talking to Elasticsearch
+		if requireProduct && res.StatusCode >= 200 && res.StatusCode < 300 {
+			if err := checkProductHeader(conn.URL(), res); err != nil {
+				c.errorf("elastic: %v", err)
+				return nil, err
+			}
+		}
 
 		// Check for errors
 		if err := checkResponse((*http.Request)(req), res, ignoreErrors...); err != nil {
@@ -1159,7 +1342,7 @@ func (c *Client) PerformRequest(ctx context.Context, method, path string, params
 		// We successfully made a request with this connection
 		conn.MarkAsHealthy()
 
-		resp, err = c.newResponse(res)
+		resp, err = c.newResponseInto(res, result)
 		if err != nil {
 			return nil, err
 		}
@@ -1177,6 +1360,26 @@ func (c *Client) PerformRequest(ctx context.Context, method, path string, params
 	return resp, nil
 }
 
+// shouldRetryStatus returns true if a request that returned the given HTTP
+// status code should be retried. If no retry statuses are configured
+// (see SetRetryStatuses), only searches without a scroll are retried on 503.
+func shouldRetryStatus(retryStatuses map[int]bool, statusCode int, path string, params url.Values) bool {
+	if retryStatuses == nil {
+		return statusCode == http.StatusServiceUnavailable && isRetryableSearch(path, params)
+	}
+	return retryStatuses[statusCode]
+}
+
+// isRetryableSearch returns true if the request is a search that can
+// safely be repeated, i.e. it targets the _search endpoint and does not
+// open a scroll context.
+func isRetryableSearch(path string, params url.Values) bool {
+	if path != "/_search" && !strings.HasSuffix(path, "/_search") {
+		return false
+	}
+	return params.Get("scroll") == ""
+}
+
 // -- Document APIs --
 
 // Index a document.
@@ -1189,6 +1392,11 @@ func (c *Client) Get() *GetService {
 	return NewGetService(c)
 }
 
+// GetSource retrieves the _source of a document.
+func (c *Client) GetSource() *GetSourceService {
+	return NewGetSourceService(c)
+}
+
 // MultiGet retrieves multiple documents in one roundtrip.
 func (c *Client) MultiGet() *MgetService {
 	return NewMgetService(c)
@@ -1268,6 +1476,16 @@ func (c *Client) MultiSearch() *MultiSearchService {
 	return NewMultiSearchService(c)
 }
 
+// SearchTemplate executes a search with a stored or inline search template.
+func (c *Client) SearchTemplate(indices ...string) *SearchTemplateService {
+	return NewSearchTemplateService(c).Index(indices...)
+}
+
+// MultiSearchTemplate executes one or more search templates in one roundtrip.
+func (c *Client) MultiSearchTemplate() *MultiSearchTemplateService {
+	return NewMultiSearchTemplateService(c)
+}
+
 // Count documents.
 func (c *Client) Count(indices ...string) *CountService {
 	return NewCountService(c).Index(indices...)
@@ -1278,10 +1496,23 @@ func (c *Client) Explain(index, typ, id string) *ExplainService {
 	return NewExplainService(c).Index(index).Type(typ).Id(id)
 }
 
-// TODO Search Template
-// TODO Search Shards API
+// RankEval evaluates the quality of ranked search results.
+func (c *Client) RankEval(indices ...string) *RankEvalService {
+	return NewRankEvalService(c).Index(indices...)
+}
+
+// SearchShards returns the indices and shards that a search request
+// would be executed against.
+func (c *Client) SearchShards(indices ...string) *SearchShardsService {
+	return NewSearchShardsService(c).Index(indices...)
+}
+
 // TODO Search Exists API
-// TODO Validate API
+
+// Validate allows a user to validate a potentially expensive query without executing it.
+func (c *Client) Validate(indices ...string) *ValidateService {
+	return NewValidateService(c).Index(indices...)
+}
 
 // FieldStats returns statistical information about fields in indices.
 func (c *Client) FieldStats(indices ...string) *FieldStatsService {
@@ -1293,6 +1524,11 @@ func (c *Client) Exists() *ExistsService {
 	return NewExistsService(c)
 }
 
+// ExistsSource checks if the _source of a document exists.
+func (c *Client) ExistsSource() *ExistsSourceService {
+	return NewExistsSourceService(c)
+}
+
 // Scroll through documents. Use this to efficiently scroll through results
 // while returning the results to a client.
 func (c *Client) Scroll(indices ...string) *ScrollService {
@@ -1353,6 +1589,16 @@ func (c *Client) CloseIndex(name string) *IndicesCloseService {
 	return NewIndicesCloseService(c).Index(name)
 }
 
+// FreezeIndex freezes an index. It is only supported by Elasticsearch 7.x.
+func (c *Client) FreezeIndex(name string) *IndicesFreezeService {
+	return NewIndicesFreezeService(c).Index(name)
+}
+
+// UnfreezeIndex unfreezes an index. It is only supported by Elasticsearch 7.x.
+func (c *Client) UnfreezeIndex(name string) *IndicesUnfreezeService {
+	return NewIndicesUnfreezeService(c).Index(name)
+}
+
 // IndexGet retrieves information about one or more indices.
 // IndexGet is only available for Elasticsearch 1.4 or later.
 func (c *Client) IndexGet(indices ...string) *IndicesGetService {
@@ -1380,12 +1626,34 @@ func (c *Client) Refresh(indices ...string) *RefreshService {
 	return NewRefreshService(c).Index(indices...)
 }
 
+// IndexRecovery returns information about shard recoveries of indices.
+func (c *Client) IndexRecovery(indices ...string) *IndicesRecoveryService {
+	return NewIndicesRecoveryService(c).Index(indices...)
+}
+
+// IndexSegments returns low level information about the Lucene
+// segments of indices.
+func (c *Client) IndexSegments(indices ...string) *IndicesSegmentsService {
+	return NewIndicesSegmentsService(c).Index(indices...)
+}
+
 // Flush asks Elasticsearch to free memory from the index and
 // flush data to disk.
 func (c *Client) Flush(indices ...string) *IndicesFlushService {
 	return NewIndicesFlushService(c).Index(indices...)
 }
 
+// SyncedFlush performs a synced flush on one or more indices.
+// It is only available up to Elasticsearch 7.x.
+func (c *Client) SyncedFlush(indices ...string) *IndicesSyncedFlushService {
+	return NewIndicesSyncedFlushService(c).Index(indices...)
+}
+
+// ClearCache clears all or specific caches of one or more indices.
+func (c *Client) ClearCache(indices ...string) *IndicesClearCacheService {
+	return NewIndicesClearCacheService(c).Index(indices...)
+}
+
 // Alias enables the caller to add and/or remove aliases.
 func (c *Client) Alias() *AliasService {
 	return NewAliasService(c)
@@ -1408,6 +1676,11 @@ func (c *Client) PutTemplate() *PutTemplateService {
 	return NewPutTemplateService(c)
 }
 
+// RenderSearchTemplate renders a search template without executing it.
+func (c *Client) RenderSearchTemplate() *RenderSearchTemplateService {
+	return NewRenderSearchTemplateService(c)
+}
+
 // DeleteTemplate deletes a search template.
 // Use IndexXXXTemplate funcs to manage index templates.
 func (c *Client) DeleteTemplate() *DeleteTemplateService {
@@ -1489,6 +1762,50 @@ func (c *Client) IngestSimulatePipeline() *IngestSimulatePipelineService {
 	return NewIngestSimulatePipelineService(c)
 }
 
+// -- Scripting APIs --
+
+// PutScript adds or updates a stored script.
+func (c *Client) PutScript() *PutScriptService {
+	return NewPutScriptService(c)
+}
+
+// GetScript reads a stored script.
+func (c *Client) GetScript() *GetScriptService {
+	return NewGetScriptService(c)
+}
+
+// DeleteScript removes a stored script.
+func (c *Client) DeleteScript() *DeleteScriptService {
+	return NewDeleteScriptService(c)
+}
+
+// ScriptsPainlessExecute executes a Painless script.
+func (c *Client) ScriptsPainlessExecute() *ScriptsPainlessExecuteService {
+	return NewScriptsPainlessExecuteService(c)
+}
+
+// -- Enrich APIs --
+
+// EnrichPutPolicy creates an enrich policy.
+func (c *Client) EnrichPutPolicy(name string) *EnrichPutPolicyService {
+	return NewEnrichPutPolicyService(c).Name(name)
+}
+
+// EnrichGetPolicy returns enrich policies by name.
+func (c *Client) EnrichGetPolicy(names ...string) *EnrichGetPolicyService {
+	return NewEnrichGetPolicyService(c).Name(names...)
+}
+
+// EnrichDeletePolicy deletes an enrich policy by name.
+func (c *Client) EnrichDeletePolicy(name string) *EnrichDeletePolicyService {
+	return NewEnrichDeletePolicyService(c).Name(name)
+}
+
+// EnrichExecutePolicy executes an enrich policy.
+func (c *Client) EnrichExecutePolicy(name string) *EnrichExecutePolicyService {
+	return NewEnrichExecutePolicyService(c).Name(name)
+}
+
 // -- Cluster APIs --
 
 // ClusterHealth retrieves the health of the cluster.
@@ -1501,6 +1818,12 @@ func (c *Client) ClusterState() *ClusterStateService {
 	return NewClusterStateService(c)
 }
 
+// ClusterPendingTasks retrieves the cluster-level changes that have not
+// been executed yet.
+func (c *Client) ClusterPendingTasks() *ClusterPendingTasksService {
+	return NewClusterPendingTasksService(c)
+}
+
 // ClusterStats retrieves cluster statistics.
 func (c *Client) ClusterStats() *ClusterStatsService {
 	return NewClusterStatsService(c)
@@ -1526,9 +1849,33 @@ func (c *Client) TasksList() *TasksListService {
 	return NewTasksListService(c)
 }
 
-// TODO Pending cluster tasks
-// TODO Cluster Reroute
-// TODO Cluster Update Settings
+// TasksGetTask retrieves a task running on the cluster.
+func (c *Client) TasksGetTask() *TasksGetTaskService {
+	return NewTasksGetTaskService(c)
+}
+
+// ClusterReroute allows for manual changes to the allocation of
+// individual shards in the cluster.
+func (c *Client) ClusterReroute() *ClusterRerouteService {
+	return NewClusterRerouteService(c)
+}
+
+// ClusterAllocationExplain explains why a shard is or is not allocated
+// to a node.
+func (c *Client) ClusterAllocationExplain() *ClusterAllocationExplainService {
+	return NewClusterAllocationExplainService(c)
+}
+
+// ClusterGetSettings retrieves the settings of the cluster.
+func (c *Client) ClusterGetSettings() *ClusterGetSettingsService {
+	return NewClusterGetSettingsService(c)
+}
+
+// ClusterPutSettings updates the settings of the cluster.
+func (c *Client) ClusterPutSettings() *ClusterPutSettingsService {
+	return NewClusterPutSettingsService(c)
+}
+
 // TODO Nodes Stats
 // TODO Nodes hot_threads
 
@@ -1541,8 +1888,22 @@ func (c *Client) TasksList() *TasksListService {
 // TODO Snapshot Get
 // TODO Snapshot Get Repository
 // TODO Snapshot Restore
-// TODO Snapshot Status
-// TODO Snapshot Verify Repository
+
+// SnapshotStatus returns information about the status of snapshots.
+func (c *Client) SnapshotStatus() *SnapshotStatusService {
+	return NewSnapshotStatusService(c)
+}
+
+// SnapshotVerifyRepository verifies a snapshot repository on all nodes
+// of the cluster.
+func (c *Client) SnapshotVerifyRepository(repository string) *SnapshotVerifyRepositoryService {
+	return NewSnapshotVerifyRepositoryService(c).Repository(repository)
+}
+
+// SnapshotCleanupRepository removes stale data from a snapshot repository.
+func (c *Client) SnapshotCleanupRepository(repository string) *SnapshotCleanupRepositoryService {
+	return NewSnapshotCleanupRepositoryService(c).Repository(repository)
+}
 
 // -- Helpers and shortcuts --
 
@@ -1606,3 +1967,31 @@ func (c *Client) WaitForGreenStatus(timeout string) error {
 func (c *Client) WaitForYellowStatus(timeout string) error {
 	return c.WaitForStatus("yellow", timeout)
 }
+
+// WaitForTask polls the task with the given id, e.g.
+// "oTUltX4IQMOUUVeiohTt8A:124", every pollInterval until it is completed.
+// This is a shortcut method for the TasksGetTask service, useful for
+// operations started with WaitForCompletion(false) like reindex.
+//
+// WaitForTask returns the completed task including its response or error.
+// If ctx is done before the task completes, the context error is returned.
+// The pollInterval must be greater than 0.
+func (c *Client) WaitForTask(ctx context.Context, taskId string, pollInterval time.Duration) (*TasksGetTaskResponse, error) {
+	if pollInterval <= 0 {
+		return nil, fmt.Errorf("elastic: poll interval must be greater than 0 in WaitForTask, got %v", pollInterval)
+	}
+	for {
+		res, err := c.TasksGetTask().TaskId(taskId).Do(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if res.Completed {
+			return res, nil
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(pollInterval):
+		}
+	}
+}
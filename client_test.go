@@ -9,10 +9,14 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io/ioutil"
 	"log"
 	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"regexp"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -926,6 +930,317 @@ func TestPerformRequestNoRetryOnValidButUnsuccessfulHttpStatus(t *testing.T) {
 	}
 }
 
+func TestPerformRequestRetrySearchOn503WithSameBody(t *testing.T) {
+	var (
+		mu     sync.Mutex
+		bodies []string
+	)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			t.Error(err)
+		}
+		mu.Lock()
+		bodies = append(bodies, string(data))
+		n := len(bodies)
+		mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		if n%2 == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			fmt.Fprint(w, `{"error":{"type":"search_phase_execution_exception"},"status":503}`)
+			return
+		}
+		fmt.Fprint(w, `{"took":1,"hits":{"total":0,"hits":[]}}`)
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(SetURL(ts.URL), SetSniff(false), SetHealthcheck(false), SetMaxRetries(2))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// POST /{index}/_search
+	_, err = client.Search().Index("twitter").Query(NewTermQuery("user", "olivere")).Do(context.TODO())
+	if err != nil {
+		t.Fatal(err)
+	}
+	// GET /_search with a body
+	_, err = client.PerformRequest(context.TODO(), "GET", "/_search", nil, `{"query":{"match_all":{}}}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if want, have := 4, len(bodies); want != have {
+		t.Fatalf("expected %d requests; got: %d", want, have)
+	}
+	for i := 0; i < len(bodies); i += 2 {
+		if bodies[i] == "" {
+			t.Fatalf("expected request %d to have a body", i)
+		}
+		if bodies[i] != bodies[i+1] {
+			t.Errorf("expected retry to replay body\n%s\n,got:\n%s", bodies[i], bodies[i+1])
+		}
+	}
+}
+
+func TestPerformRequestNoRetryOn503ForScroll(t *testing.T) {
+	var numReqs int
+	fail := func(r *http.Request) (*http.Response, error) {
+		numReqs++
+		return &http.Response{Request: r, StatusCode: http.StatusServiceUnavailable}, nil
+	}
+	tr := &failingTransport{path: "/twitter/_search", fail: fail}
+	httpClient := &http.Client{Transport: tr}
+
+	client, err := NewClient(SetHttpClient(httpClient), SetMaxRetries(5), SetHealthcheck(false), SetSniff(false))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	params := url.Values{}
+	params.Set("scroll", "1m")
+	_, err = client.PerformRequest(context.TODO(), "POST", "/twitter/_search", params, `{"query":{"match_all":{}}}`)
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if want, have := 1, numReqs; want != have {
+		t.Errorf("expected %d requests; got: %d", want, have)
+	}
+}
+
+func TestPerformRequestWithDeprecationLog(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Add("Warning", `299 Elasticsearch-6.8.0 "first deprecation"`)
+		w.Header().Add("Warning", `299 Elasticsearch-6.8.0 "second deprecation"`)
+		fmt.Fprint(w, `{"took":1,"hits":{"total":0,"hits":[]}}`)
+	}))
+	defer ts.Close()
+
+	var (
+		paths    []string
+		warnings []string
+	)
+	deprecationlog := func(req *http.Request, warning string) {
+		paths = append(paths, req.URL.Path)
+		warnings = append(warnings, warning)
+	}
+	client, err := NewClient(SetURL(ts.URL), SetSniff(false), SetHealthcheck(false), SetMaxRetries(0), SetDeprecationLog(deprecationlog))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := client.Search().Index("twitter").Do(context.TODO())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Hits == nil {
+		t.Fatal("expected Hits != nil")
+	}
+	expected := []string{
+		`299 Elasticsearch-6.8.0 "first deprecation"`,
+		`299 Elasticsearch-6.8.0 "second deprecation"`,
+	}
+	if want, have := len(expected), len(warnings); want != have {
+		t.Fatalf("expected %d warnings; got: %d (%v)", want, have, warnings)
+	}
+	for i := range expected {
+		if want, have := expected[i], warnings[i]; want != have {
+			t.Errorf("warning #%d: expected %q; got: %q", i, want, have)
+		}
+		if want, have := "/twitter/_search", paths[i]; want != have {
+			t.Errorf("warning #%d: expected request path %q; got: %q", i, want, have)
+		}
+	}
+}
+
+func TestPerformRequestWithRetryStatuses(t *testing.T) {
+	tests := []struct {
+		Path     string
+		Status   int
+		Statuses []int
+		Expected int // number of requests
+	}{
+		// Listed statuses are retried, for any request
+		{"/fail", http.StatusServiceUnavailable, []int{502, 503, 504}, 3},
+		{"/fail", http.StatusBadGateway, []int{502, 503, 504}, 3},
+		// Statuses not in the set are not retried
+		{"/fail", http.StatusTooManyRequests, []int{502, 503, 504}, 1},
+		{"/fail", http.StatusInternalServerError, []int{502, 503, 504}, 1},
+		// An empty set only retries on connection errors
+		{"/twitter/_search", http.StatusServiceUnavailable, []int{}, 1},
+	}
+
+	for i, test := range tests {
+		var numReqs int
+		status := test.Status
+		fail := func(r *http.Request) (*http.Response, error) {
+			numReqs++
+			return &http.Response{Request: r, StatusCode: status}, nil
+		}
+		tr := &failingTransport{path: test.Path, fail: fail}
+		httpClient := &http.Client{Transport: tr}
+
+		client, err := NewClient(
+			SetHttpClient(httpClient),
+			SetMaxRetries(3),
+			SetHealthcheck(false),
+			SetSniff(false),
+			SetRetryStatuses(test.Statuses...))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		res, err := client.PerformRequest(context.TODO(), "GET", test.Path, nil, nil)
+		if err == nil {
+			t.Fatalf("case #%d: expected error", i+1)
+		}
+		if res == nil {
+			t.Fatalf("case #%d: expected response, got nil", i+1)
+		}
+		if want, have := test.Status, res.StatusCode; want != have {
+			t.Errorf("case #%d: expected status code = %d; got: %d", i+1, want, have)
+		}
+		if want, have := test.Expected, numReqs; want != have {
+			t.Errorf("case #%d: expected %d requests; got: %d", i+1, want, have)
+		}
+	}
+}
+
+func TestPerformRequestWithMaxResponseSize(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/small":
+			fmt.Fprint(w, `{"ok":true}`)
+		case "/chunked":
+			// Stream without a Content-Length
+			fmt.Fprint(w, `{"data":"`)
+			w.(http.Flusher).Flush()
+			fmt.Fprint(w, strings.Repeat("x", 4096))
+			fmt.Fprint(w, `"}`)
+		default:
+			fmt.Fprintf(w, `{"data":"%s"}`, strings.Repeat("x", 4096))
+		}
+	}))
+	defer ts.Close()
+
+	tests := []struct {
+		Path     string
+		Decoder  Decoder
+		Expected error
+	}{
+		{"/small", &DefaultDecoder{}, nil},
+		{"/large", &DefaultDecoder{}, ErrResponseTooLarge},
+		{"/chunked", &DefaultDecoder{}, ErrResponseTooLarge},
+		{"/chunked", &StreamDecoder{}, ErrResponseTooLarge},
+	}
+
+	for i, test := range tests {
+		client, err := NewClient(SetURL(ts.URL), SetSniff(false), SetHealthcheck(false), SetMaxRetries(0), SetMaxResponseSize(1024), SetDecoder(test.Decoder))
+		if err != nil {
+			t.Fatal(err)
+		}
+		var v map[string]interface{}
+		_, err = client.performRequest(context.TODO(), "GET", test.Path, nil, nil, &v)
+		if err != test.Expected {
+			t.Errorf("case #%d: expected error %v; got: %v", i+1, test.Expected, err)
+		}
+	}
+
+	// Unlimited by default
+	client, err := NewClient(SetURL(ts.URL), SetSniff(false), SetHealthcheck(false), SetMaxRetries(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.PerformRequest(context.TODO(), "GET", "/chunked", nil, nil); err != nil {
+		t.Fatalf("expected no error; got: %v", err)
+	}
+}
+
+func TestClientWithRequireProductHeader(t *testing.T) {
+	tests := []struct {
+		Product string // empty for no header
+		Valid   bool
+	}{
+		{"Elasticsearch", true},
+		{"", false},
+		{"OpenSearch", false},
+	}
+
+	for i, test := range tests {
+		product := test.Product
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if product != "" {
+				w.Header().Set("X-Elastic-Product", product)
+			}
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprint(w, `{"took":1,"hits":{"total":0,"hits":[]}}`)
+		}))
+
+		// Startup healthcheck
+		client, err := NewClient(SetURL(ts.URL), SetSniff(false), SetHealthcheckTimeoutStartup(2*time.Second), SetRequireProductHeader(true))
+		if test.Valid {
+			if err != nil {
+				t.Errorf("case #%d: expected no error; got: %v", i+1, err)
+			} else {
+				client.Stop()
+			}
+		} else if err == nil {
+			client.Stop()
+			t.Errorf("case #%d: expected startup healthcheck to fail", i+1)
+		} else if !strings.Contains(err.Error(), "X-Elastic-Product") && !strings.Contains(err.Error(), product) {
+			t.Errorf("case #%d: expected descriptive error; got: %v", i+1, err)
+		}
+
+		// Startup only: requests are not checked
+		client, err = NewClient(SetURL(ts.URL), SetSniff(false), SetHealthcheck(false), SetMaxRetries(0), SetRequireProductHeader(true))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := client.Search().Index("twitter").Do(context.TODO()); err != nil {
+			t.Errorf("case #%d: expected no error with startup check only; got: %v", i+1, err)
+		}
+
+		// Every request, including the startup healthcheck
+		client, err = NewClient(SetURL(ts.URL), SetSniff(false), SetHealthcheckTimeoutStartup(2*time.Second), SetRequireProductHeaderOnEveryRequest(true))
+		if test.Valid && err != nil {
+			t.Errorf("case #%d: expected no error; got: %v", i+1, err)
+		}
+		if !test.Valid && err == nil {
+			client.Stop()
+			t.Errorf("case #%d: expected startup healthcheck to fail", i+1)
+		}
+		if err == nil {
+			client.Stop()
+		}
+		client, err = NewClient(SetURL(ts.URL), SetSniff(false), SetHealthcheck(false), SetMaxRetries(0), SetRequireProductHeaderOnEveryRequest(true))
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = client.Search().Index("twitter").Do(context.TODO())
+		if test.Valid && err != nil {
+			t.Errorf("case #%d: expected no error; got: %v", i+1, err)
+		}
+		if !test.Valid && err == nil {
+			t.Errorf("case #%d: expected search to fail", i+1)
+		}
+
+		// Disabled by default
+		client, err = NewClient(SetURL(ts.URL), SetSniff(false), SetHealthcheck(false), SetMaxRetries(0))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := client.Search().Index("twitter").Do(context.TODO()); err != nil {
+			t.Errorf("case #%d: expected no error without product check; got: %v", i+1, err)
+		}
+
+		ts.Close()
+	}
+}
+
 // failingBody will return an error when json.Marshal is called on it.
 type failingBody struct{}
 
@@ -0,0 +1,220 @@
+// Copyright 2012-present Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+import (
+	"fmt"
+	"net/url"
+
+	"golang.org/x/net/context"
+)
+
+// ClusterAllocationExplainService explains why a shard is or is not
+// allocated to a node. If no index, shard, and primary are given, the
+// API explains the first unassigned shard it finds.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/5.1/cluster-allocation-explain.html
+// for details.
+type ClusterAllocationExplainService struct {
+	client              *Client
+	pretty              bool
+	index               string
+	shard               *int
+	primary             *bool
+	includeYesDecisions *bool
+	includeDiskInfo     *bool
+	bodyJson            interface{}
+	bodyString          string
+}
+
+// NewClusterAllocationExplainService creates a new ClusterAllocationExplainService.
+func NewClusterAllocationExplainService(client *Client) *ClusterAllocationExplainService {
+	return &ClusterAllocationExplainService{
+		client: client,
+	}
+}
+
+// Index is the name of the index of the shard to explain.
+func (s *ClusterAllocationExplainService) Index(index string) *ClusterAllocationExplainService {
+	s.index = index
+	return s
+}
+
+// Shard is the number of the shard to explain.
+func (s *ClusterAllocationExplainService) Shard(shard int) *ClusterAllocationExplainService {
+	s.shard = &shard
+	return s
+}
+
+// Primary indicates whether to explain the primary shard (true)
+// or a replica (false).
+func (s *ClusterAllocationExplainService) Primary(primary bool) *ClusterAllocationExplainService {
+	s.primary = &primary
+	return s
+}
+
+// IncludeYesDecisions indicates whether to return YES decisions in the
+// explanation (default: false).
+func (s *ClusterAllocationExplainService) IncludeYesDecisions(includeYesDecisions bool) *ClusterAllocationExplainService {
+	s.includeYesDecisions = &includeYesDecisions
+	return s
+}
+
+// IncludeDiskInfo indicates whether to return information about disk usage
+// and shard sizes (default: false).
+func (s *ClusterAllocationExplainService) IncludeDiskInfo(includeDiskInfo bool) *ClusterAllocationExplainService {
+	s.includeDiskInfo = &includeDiskInfo
+	return s
+}
+
+// BodyJson sets the body of the request. It takes precedence over
+// Index, Shard, and Primary.
+func (s *ClusterAllocationExplainService) BodyJson(body interface{}) *ClusterAllocationExplainService {
+	s.bodyJson = body
+	return s
+}
+
+// BodyString sets the body of the request as a string. It takes precedence
+// over Index, Shard, and Primary.
+func (s *ClusterAllocationExplainService) BodyString(body string) *ClusterAllocationExplainService {
+	s.bodyString = body
+	return s
+}
+
+// Pretty indicates that the JSON response be indented and human readable.
+func (s *ClusterAllocationExplainService) Pretty(pretty bool) *ClusterAllocationExplainService {
+	s.pretty = pretty
+	return s
+}
+
+// buildURL builds the URL for the operation.
+func (s *ClusterAllocationExplainService) buildURL() (string, url.Values, error) {
+	// Build URL
+	path := "/_cluster/allocation/explain"
+
+	// Add query string parameters
+	params := url.Values{}
+	if s.pretty {
+		params.Set("pretty", "1")
+	}
+	if s.includeYesDecisions != nil {
+		params.Set("include_yes_decisions", fmt.Sprintf("%v", *s.includeYesDecisions))
+	}
+	if s.includeDiskInfo != nil {
+		params.Set("include_disk_info", fmt.Sprintf("%v", *s.includeDiskInfo))
+	}
+	return path, params, nil
+}
+
+// Validate checks if the operation is valid.
+func (s *ClusterAllocationExplainService) Validate() error {
+	return nil
+}
+
+// getBody returns the body of the request.
+func (s *ClusterAllocationExplainService) getBody() interface{} {
+	if s.bodyJson != nil {
+		return s.bodyJson
+	}
+	if s.bodyString != "" {
+		return s.bodyString
+	}
+	body := make(map[string]interface{})
+	if s.index != "" {
+		body["index"] = s.index
+	}
+	if s.shard != nil {
+		body["shard"] = *s.shard
+	}
+	if s.primary != nil {
+		body["primary"] = *s.primary
+	}
+	if len(body) == 0 {
+		return nil
+	}
+	return body
+}
+
+// Do executes the operation.
+func (s *ClusterAllocationExplainService) Do(ctx context.Context) (*ClusterAllocationExplainResponse, error) {
+	// Check pre-conditions
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+
+	// Get URL for request
+	path, params, err := s.buildURL()
+	if err != nil {
+		return nil, err
+	}
+
+	// Get HTTP response
+	res, err := s.client.PerformRequest(ctx, "POST", path, params, s.getBody())
+	if err != nil {
+		return nil, err
+	}
+
+	// Return operation response
+	ret := new(ClusterAllocationExplainResponse)
+	if err := s.client.decoder.Decode(res.Body, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// ClusterAllocationExplainResponse is the response of
+// ClusterAllocationExplainService.Do.
+type ClusterAllocationExplainResponse struct {
+	Index                   string                           `json:"index,omitempty"`
+	Shard                   int                              `json:"shard"`
+	Primary                 bool                             `json:"primary"`
+	CurrentState            string                           `json:"current_state,omitempty"`
+	CurrentNode             *AllocationExplainCurrentNode    `json:"current_node,omitempty"`
+	UnassignedInfo          *AllocationExplainUnassignedInfo `json:"unassigned_info,omitempty"`
+	CanAllocate             string                           `json:"can_allocate,omitempty"`
+	AllocateExplanation     string                           `json:"allocate_explanation,omitempty"`
+	CanRemainOnCurrentNode  string                           `json:"can_remain_on_current_node,omitempty"`
+	CanRebalanceCluster     string                           `json:"can_rebalance_cluster,omitempty"`
+	CanRebalanceToOtherNode string                           `json:"can_rebalance_to_other_node,omitempty"`
+	RebalanceExplanation    string                           `json:"rebalance_explanation,omitempty"`
+	NodeAllocationDecisions []*AllocationExplainNodeDecision `json:"node_allocation_decisions,omitempty"`
+	ClusterInfo             map[string]interface{}           `json:"cluster_info,omitempty"`
+}
+
+// AllocationExplainCurrentNode describes the node a shard is currently
+// allocated to.
+type AllocationExplainCurrentNode struct {
+	Id               string            `json:"id"`
+	Name             string            `json:"name"`
+	TransportAddress string            `json:"transport_address"`
+	Attributes       map[string]string `json:"attributes,omitempty"`
+	WeightRanking    int               `json:"weight_ranking,omitempty"`
+}
+
+// AllocationExplainUnassignedInfo describes why a shard is unassigned.
+type AllocationExplainUnassignedInfo struct {
+	Reason               string `json:"reason"`
+	At                   string `json:"at"`
+	Details              string `json:"details,omitempty"`
+	LastAllocationStatus string `json:"last_allocation_status,omitempty"`
+}
+
+// AllocationExplainNodeDecision is the allocation decision for a single node.
+type AllocationExplainNodeDecision struct {
+	NodeId           string                      `json:"node_id"`
+	NodeName         string                      `json:"node_name"`
+	TransportAddress string                      `json:"transport_address"`
+	NodeAttributes   map[string]string           `json:"node_attributes,omitempty"`
+	NodeDecision     string                      `json:"node_decision"`
+	WeightRanking    int                         `json:"weight_ranking,omitempty"`
+	Deciders         []*AllocationExplainDecider `json:"deciders,omitempty"`
+}
+
+// AllocationExplainDecider is the decision of a single allocation decider.
+type AllocationExplainDecider struct {
+	Decider     string `json:"decider"`
+	Decision    string `json:"decision"`
+	Explanation string `json:"explanation"`
+}
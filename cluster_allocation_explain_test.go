@@ -0,0 +1,137 @@
+// Copyright 2012-present Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+import (
+	"encoding/json"
+	"net/url"
+	"testing"
+)
+
+func TestClusterAllocationExplainURL(t *testing.T) {
+	tests := []struct {
+		Service        *ClusterAllocationExplainService
+		ExpectedParams url.Values
+	}{
+		{
+			Service:        &ClusterAllocationExplainService{},
+			ExpectedParams: url.Values{},
+		},
+		{
+			Service:        (&ClusterAllocationExplainService{}).IncludeYesDecisions(true).IncludeDiskInfo(true),
+			ExpectedParams: url.Values{"include_yes_decisions": []string{"true"}, "include_disk_info": []string{"true"}},
+		},
+	}
+
+	for i, test := range tests {
+		path, params, err := test.Service.buildURL()
+		if err != nil {
+			t.Fatalf("case #%d: expected no error, got %v", i+1, err)
+		}
+		if want := "/_cluster/allocation/explain"; path != want {
+			t.Errorf("case #%d: expected URL path = %q; got: %q", i+1, want, path)
+		}
+		if params.Encode() != test.ExpectedParams.Encode() {
+			t.Errorf("case #%d: expected URL params = %v; got: %v", i+1, test.ExpectedParams, params)
+		}
+	}
+}
+
+func TestClusterAllocationExplainBody(t *testing.T) {
+	tests := []struct {
+		Service  *ClusterAllocationExplainService
+		Expected string
+	}{
+		{
+			Service:  &ClusterAllocationExplainService{},
+			Expected: `null`,
+		},
+		{
+			Service:  (&ClusterAllocationExplainService{}).Index("twitter").Shard(0).Primary(true),
+			Expected: `{"index":"twitter","primary":true,"shard":0}`,
+		},
+		{
+			Service:  (&ClusterAllocationExplainService{}).Index("twitter").BodyJson(map[string]interface{}{"index": "other"}),
+			Expected: `{"index":"other"}`,
+		},
+	}
+
+	for i, test := range tests {
+		data, err := json.Marshal(test.Service.getBody())
+		if err != nil {
+			t.Fatalf("case #%d: marshaling to JSON failed: %v", i+1, err)
+		}
+		if got := string(data); got != test.Expected {
+			t.Errorf("case #%d: expected\n%s\n,got:\n%s", i+1, test.Expected, got)
+		}
+	}
+}
+
+func TestClusterAllocationExplainResponseNoValidShardCopy(t *testing.T) {
+	body := `{
+		"index": "idx",
+		"shard": 0,
+		"primary": true,
+		"current_state": "unassigned",
+		"unassigned_info": {
+			"reason": "NODE_LEFT",
+			"at": "2017-01-04T18:03:28.464Z",
+			"details": "node_left[OIWe8UhhThCK0V5XfmdrmQ]",
+			"last_allocation_status": "no_valid_shard_copy"
+		},
+		"can_allocate": "no_valid_shard_copy",
+		"allocate_explanation": "cannot allocate because a previous copy of the primary shard existed but can no longer be found on the nodes in the cluster",
+		"node_allocation_decisions": [
+			{
+				"node_id": "8qt2rY-pT6KNZB3-hGfLnw",
+				"node_name": "node-0",
+				"transport_address": "127.0.0.1:9401",
+				"node_decision": "no",
+				"deciders": [
+					{
+						"decider": "same_shard",
+						"decision": "NO",
+						"explanation": "the shard cannot be allocated to the same node on which a copy of the shard already exists"
+					}
+				]
+			}
+		]
+	}`
+	var res ClusterAllocationExplainResponse
+	if err := json.Unmarshal([]byte(body), &res); err != nil {
+		t.Fatal(err)
+	}
+	if want, have := "unassigned", res.CurrentState; want != have {
+		t.Errorf("expected current_state = %q; got: %q", want, have)
+	}
+	if want, have := "no_valid_shard_copy", res.CanAllocate; want != have {
+		t.Errorf("expected can_allocate = %q; got: %q", want, have)
+	}
+	if res.AllocateExplanation == "" {
+		t.Errorf("expected allocate_explanation; got: %q", res.AllocateExplanation)
+	}
+	if res.UnassignedInfo == nil {
+		t.Fatal("expected unassigned_info; got: nil")
+	}
+	if want, have := "NODE_LEFT", res.UnassignedInfo.Reason; want != have {
+		t.Errorf("expected unassigned_info.reason = %q; got: %q", want, have)
+	}
+	if want, have := 1, len(res.NodeAllocationDecisions); want != have {
+		t.Fatalf("expected %d node allocation decisions; got: %d", want, have)
+	}
+	node := res.NodeAllocationDecisions[0]
+	if want, have := "node-0", node.NodeName; want != have {
+		t.Errorf("expected node_name = %q; got: %q", want, have)
+	}
+	if want, have := 1, len(node.Deciders); want != have {
+		t.Fatalf("expected %d deciders; got: %d", want, have)
+	}
+	if want, have := "same_shard", node.Deciders[0].Decider; want != have {
+		t.Errorf("expected decider = %q; got: %q", want, have)
+	}
+	if want, have := "NO", node.Deciders[0].Decision; want != have {
+		t.Errorf("expected decision = %q; got: %q", want, have)
+	}
+}
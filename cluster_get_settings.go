@@ -0,0 +1,128 @@
+// Copyright 2012-present Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+import (
+	"fmt"
+	"net/url"
+
+	"golang.org/x/net/context"
+)
+
+// ClusterGetSettingsService returns the persistent and transient
+// settings of the cluster, and optionally its default settings.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/current/cluster-get-settings.html
+// for details.
+type ClusterGetSettingsService struct {
+	client          *Client
+	pretty          bool
+	flatSettings    *bool
+	includeDefaults *bool
+	masterTimeout   string
+	timeout         string
+}
+
+// NewClusterGetSettingsService creates a new ClusterGetSettingsService.
+func NewClusterGetSettingsService(client *Client) *ClusterGetSettingsService {
+	return &ClusterGetSettingsService{
+		client: client,
+	}
+}
+
+// FlatSettings indicates whether to return settings in flat format,
+// e.g. "indices.recovery.max_bytes_per_sec" instead of nested objects.
+func (s *ClusterGetSettingsService) FlatSettings(flatSettings bool) *ClusterGetSettingsService {
+	s.flatSettings = &flatSettings
+	return s
+}
+
+// IncludeDefaults indicates whether to return all default cluster settings.
+func (s *ClusterGetSettingsService) IncludeDefaults(includeDefaults bool) *ClusterGetSettingsService {
+	s.includeDefaults = &includeDefaults
+	return s
+}
+
+// MasterTimeout specifies an explicit operation timeout for connection to master node.
+func (s *ClusterGetSettingsService) MasterTimeout(masterTimeout string) *ClusterGetSettingsService {
+	s.masterTimeout = masterTimeout
+	return s
+}
+
+// Timeout specifies an explicit operation timeout.
+func (s *ClusterGetSettingsService) Timeout(timeout string) *ClusterGetSettingsService {
+	s.timeout = timeout
+	return s
+}
+
+// Pretty indicates that the JSON response be indented and human readable.
+func (s *ClusterGetSettingsService) Pretty(pretty bool) *ClusterGetSettingsService {
+	s.pretty = pretty
+	return s
+}
+
+// buildURL builds the URL for the operation.
+func (s *ClusterGetSettingsService) buildURL() (string, url.Values, error) {
+	// Build URL
+	path := "/_cluster/settings"
+
+	// Add query string parameters
+	params := url.Values{}
+	if s.pretty {
+		params.Set("pretty", "1")
+	}
+	if s.flatSettings != nil {
+		params.Set("flat_settings", fmt.Sprintf("%v", *s.flatSettings))
+	}
+	if s.includeDefaults != nil {
+		params.Set("include_defaults", fmt.Sprintf("%v", *s.includeDefaults))
+	}
+	if s.masterTimeout != "" {
+		params.Set("master_timeout", s.masterTimeout)
+	}
+	if s.timeout != "" {
+		params.Set("timeout", s.timeout)
+	}
+	return path, params, nil
+}
+
+// Validate checks if the operation is valid.
+func (s *ClusterGetSettingsService) Validate() error {
+	return nil
+}
+
+// Do executes the operation.
+func (s *ClusterGetSettingsService) Do(ctx context.Context) (*ClusterGetSettingsResponse, error) {
+	// Check pre-conditions
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+
+	// Get URL for request
+	path, params, err := s.buildURL()
+	if err != nil {
+		return nil, err
+	}
+
+	// Get HTTP response
+	res, err := s.client.PerformRequest(ctx, "GET", path, params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return operation response
+	ret := new(ClusterGetSettingsResponse)
+	if err := s.client.decoder.Decode(res.Body, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// ClusterGetSettingsResponse is the response of ClusterGetSettingsService.Do.
+type ClusterGetSettingsResponse struct {
+	Persistent map[string]interface{} `json:"persistent"`
+	Transient  map[string]interface{} `json:"transient"`
+	Defaults   map[string]interface{} `json:"defaults,omitempty"` // only with IncludeDefaults
+}
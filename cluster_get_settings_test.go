@@ -0,0 +1,88 @@
+// Copyright 2012-present Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"golang.org/x/net/context"
+)
+
+func TestClusterGetSettingsBuildURL(t *testing.T) {
+	client := setupTestClient(t)
+
+	tests := []struct {
+		Service       *ClusterGetSettingsService
+		ExpectedPath  string
+		ExpectedQuery string
+	}{
+		{
+			client.ClusterGetSettings(),
+			"/_cluster/settings",
+			"",
+		},
+		{
+			client.ClusterGetSettings().FlatSettings(true).IncludeDefaults(true),
+			"/_cluster/settings",
+			"flat_settings=true&include_defaults=true",
+		},
+		{
+			client.ClusterGetSettings().MasterTimeout("30s").Timeout("10s"),
+			"/_cluster/settings",
+			"master_timeout=30s&timeout=10s",
+		},
+	}
+
+	for i, test := range tests {
+		path, params, err := test.Service.buildURL()
+		if err != nil {
+			t.Fatalf("case #%d: %v", i+1, err)
+		}
+		if want, have := test.ExpectedPath, path; want != have {
+			t.Errorf("case #%d: expected path %q; got: %q", i+1, want, have)
+		}
+		if want, have := test.ExpectedQuery, params.Encode(); want != have {
+			t.Errorf("case #%d: expected query %q; got: %q", i+1, want, have)
+		}
+	}
+}
+
+func TestClusterGetSettingsFlat(t *testing.T) {
+	var query string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.RawQuery
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{
+			"persistent": {"indices.recovery.max_bytes_per_sec": "50mb"},
+			"transient": {"cluster.routing.allocation.enable": "primaries"},
+			"defaults": {"cluster.name": "elasticsearch"}
+		}`)
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(SetURL(ts.URL), SetSniff(false), SetHealthcheck(false), SetMaxRetries(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := client.ClusterGetSettings().FlatSettings(true).IncludeDefaults(true).Do(context.TODO())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, have := "flat_settings=true&include_defaults=true", query; want != have {
+		t.Errorf("expected query %q; got: %q", want, have)
+	}
+	if want, have := "50mb", res.Persistent["indices.recovery.max_bytes_per_sec"]; want != have {
+		t.Errorf("expected persistent setting %v; got: %v", want, have)
+	}
+	if want, have := "primaries", res.Transient["cluster.routing.allocation.enable"]; want != have {
+		t.Errorf("expected transient setting %v; got: %v", want, have)
+	}
+	if want, have := "elasticsearch", res.Defaults["cluster.name"]; want != have {
+		t.Errorf("expected default setting %v; got: %v", want, have)
+	}
+}
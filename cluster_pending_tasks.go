@@ -0,0 +1,118 @@
+// Copyright 2012-present Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+import (
+	"fmt"
+	"net/url"
+
+	"golang.org/x/net/context"
+)
+
+// ClusterPendingTasksService returns a list of cluster-level changes,
+// e.g. index creation or mapping updates, that have not been executed
+// by the master node yet.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/current/cluster-pending.html
+// for details.
+type ClusterPendingTasksService struct {
+	client        *Client
+	pretty        bool
+	local         *bool
+	masterTimeout string
+}
+
+// NewClusterPendingTasksService creates a new ClusterPendingTasksService.
+func NewClusterPendingTasksService(client *Client) *ClusterPendingTasksService {
+	return &ClusterPendingTasksService{
+		client: client,
+	}
+}
+
+// Local indicates whether to return local information. When set, it does not
+// retrieve the pending tasks from master node (default: false).
+func (s *ClusterPendingTasksService) Local(local bool) *ClusterPendingTasksService {
+	s.local = &local
+	return s
+}
+
+// MasterTimeout specifies timeout for connection to master.
+func (s *ClusterPendingTasksService) MasterTimeout(masterTimeout string) *ClusterPendingTasksService {
+	s.masterTimeout = masterTimeout
+	return s
+}
+
+// Pretty indicates that the JSON response be indented and human readable.
+func (s *ClusterPendingTasksService) Pretty(pretty bool) *ClusterPendingTasksService {
+	s.pretty = pretty
+	return s
+}
+
+// buildURL builds the URL for the operation.
+func (s *ClusterPendingTasksService) buildURL() (string, url.Values, error) {
+	// Build URL
+	path := "/_cluster/pending_tasks"
+
+	// Add query string parameters
+	params := url.Values{}
+	if s.pretty {
+		params.Set("pretty", "1")
+	}
+	if s.local != nil {
+		params.Set("local", fmt.Sprintf("%v", *s.local))
+	}
+	if s.masterTimeout != "" {
+		params.Set("master_timeout", s.masterTimeout)
+	}
+	return path, params, nil
+}
+
+// Validate checks if the operation is valid.
+func (s *ClusterPendingTasksService) Validate() error {
+	return nil
+}
+
+// Do executes the operation.
+func (s *ClusterPendingTasksService) Do(ctx context.Context) (*ClusterPendingTasksResponse, error) {
+	// Check pre-conditions
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+
+	// Get URL for request
+	path, params, err := s.buildURL()
+	if err != nil {
+		return nil, err
+	}
+
+	// Get HTTP response
+	res, err := s.client.PerformRequest(ctx, "GET", path, params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return operation response
+	ret := new(ClusterPendingTasksResponse)
+	if err := s.client.decoder.Decode(res.Body, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// ClusterPendingTasksResponse is the response of ClusterPendingTasksService.Do.
+type ClusterPendingTasksResponse struct {
+	Tasks []*ClusterPendingTask `json:"tasks"`
+}
+
+// ClusterPendingTask is a single cluster-level change waiting to be
+// executed by the master node.
+type ClusterPendingTask struct {
+	InsertOrder       int64  `json:"insert_order"`
+	Priority          string `json:"priority"` // e.g. IMMEDIATE, URGENT, HIGH, NORMAL, LOW, LANGUID
+	Source            string `json:"source"`
+	Executing         bool   `json:"executing"`
+	TimeInQueueMillis int64  `json:"time_in_queue_millis"`
+	TimeInQueue       string `json:"time_in_queue,omitempty"` // e.g. "86ms"
+}
@@ -0,0 +1,106 @@
+// Copyright 2012-present Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"golang.org/x/net/context"
+)
+
+func TestClusterPendingTasksBuildURL(t *testing.T) {
+	client := setupTestClient(t)
+
+	tests := []struct {
+		Service       *ClusterPendingTasksService
+		ExpectedPath  string
+		ExpectedQuery string
+	}{
+		{
+			client.ClusterPendingTasks(),
+			"/_cluster/pending_tasks",
+			"",
+		},
+		{
+			client.ClusterPendingTasks().Local(true).MasterTimeout("5s"),
+			"/_cluster/pending_tasks",
+			"local=true&master_timeout=5s",
+		},
+	}
+
+	for i, test := range tests {
+		path, params, err := test.Service.buildURL()
+		if err != nil {
+			t.Fatalf("case #%d: %v", i+1, err)
+		}
+		if want, have := test.ExpectedPath, path; want != have {
+			t.Errorf("case #%d: expected path %q; got: %q", i+1, want, have)
+		}
+		if want, have := test.ExpectedQuery, params.Encode(); want != have {
+			t.Errorf("case #%d: expected query %q; got: %q", i+1, want, have)
+		}
+	}
+}
+
+func TestClusterPendingTasksResponse(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{
+			"tasks": [
+				{
+					"insert_order": 101,
+					"priority": "URGENT",
+					"source": "create-index [foo_9], cause [api]",
+					"executing": true,
+					"time_in_queue_millis": 86,
+					"time_in_queue": "86ms"
+				},
+				{
+					"insert_order": 46,
+					"priority": "HIGH",
+					"source": "shard-started ([foo_2][1], node[tMTocMvQQgGCkj7QDHl3OA], [P], s[INITIALIZING]), reason [after recovery from shard_store]",
+					"executing": false,
+					"time_in_queue_millis": 842,
+					"time_in_queue": "842ms"
+				}
+			]
+		}`)
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(SetURL(ts.URL), SetSniff(false), SetHealthcheck(false), SetMaxRetries(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := client.ClusterPendingTasks().Do(context.TODO())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, have := 2, len(res.Tasks); want != have {
+		t.Fatalf("expected %d tasks; got: %d", want, have)
+	}
+	task := res.Tasks[0]
+	if want, have := int64(101), task.InsertOrder; want != have {
+		t.Errorf("expected insert_order %d; got: %d", want, have)
+	}
+	if want, have := "URGENT", task.Priority; want != have {
+		t.Errorf("expected priority %q; got: %q", want, have)
+	}
+	if want, have := "create-index [foo_9], cause [api]", task.Source; want != have {
+		t.Errorf("expected source %q; got: %q", want, have)
+	}
+	if !task.Executing {
+		t.Error("expected task to be executing")
+	}
+	if want, have := int64(86), task.TimeInQueueMillis; want != have {
+		t.Errorf("expected time_in_queue_millis %d; got: %d", want, have)
+	}
+	if want, have := int64(842), res.Tasks[1].TimeInQueueMillis; want != have {
+		t.Errorf("expected time_in_queue_millis %d; got: %d", want, have)
+	}
+}
@@ -0,0 +1,167 @@
+// Copyright 2012-present Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+
+	"golang.org/x/net/context"
+)
+
+// ClusterPutSettingsService updates the persistent and transient
+// settings of the cluster. Persistent settings survive a full cluster
+// restart, transient settings do not. Set a setting to nil to reset it
+// to its default.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/current/cluster-update-settings.html
+// for details.
+type ClusterPutSettingsService struct {
+	client        *Client
+	pretty        bool
+	flatSettings  *bool
+	masterTimeout string
+	timeout       string
+	persistent    map[string]interface{}
+	transient     map[string]interface{}
+}
+
+// NewClusterPutSettingsService creates a new ClusterPutSettingsService.
+func NewClusterPutSettingsService(client *Client) *ClusterPutSettingsService {
+	return &ClusterPutSettingsService{
+		client: client,
+	}
+}
+
+// Persistent sets the persistent settings to update.
+func (s *ClusterPutSettingsService) Persistent(settings map[string]interface{}) *ClusterPutSettingsService {
+	s.persistent = settings
+	return s
+}
+
+// PersistentSetting sets a single persistent setting to update.
+func (s *ClusterPutSettingsService) PersistentSetting(name string, value interface{}) *ClusterPutSettingsService {
+	if s.persistent == nil {
+		s.persistent = make(map[string]interface{})
+	}
+	s.persistent[name] = value
+	return s
+}
+
+// Transient sets the transient settings to update.
+func (s *ClusterPutSettingsService) Transient(settings map[string]interface{}) *ClusterPutSettingsService {
+	s.transient = settings
+	return s
+}
+
+// TransientSetting sets a single transient setting to update.
+func (s *ClusterPutSettingsService) TransientSetting(name string, value interface{}) *ClusterPutSettingsService {
+	if s.transient == nil {
+		s.transient = make(map[string]interface{})
+	}
+	s.transient[name] = value
+	return s
+}
+
+// FlatSettings indicates whether to return settings in flat format.
+func (s *ClusterPutSettingsService) FlatSettings(flatSettings bool) *ClusterPutSettingsService {
+	s.flatSettings = &flatSettings
+	return s
+}
+
+// MasterTimeout specifies an explicit operation timeout for connection to master node.
+func (s *ClusterPutSettingsService) MasterTimeout(masterTimeout string) *ClusterPutSettingsService {
+	s.masterTimeout = masterTimeout
+	return s
+}
+
+// Timeout specifies an explicit operation timeout.
+func (s *ClusterPutSettingsService) Timeout(timeout string) *ClusterPutSettingsService {
+	s.timeout = timeout
+	return s
+}
+
+// Pretty indicates that the JSON response be indented and human readable.
+func (s *ClusterPutSettingsService) Pretty(pretty bool) *ClusterPutSettingsService {
+	s.pretty = pretty
+	return s
+}
+
+// buildURL builds the URL for the operation.
+func (s *ClusterPutSettingsService) buildURL() (string, url.Values, error) {
+	// Build URL
+	path := "/_cluster/settings"
+
+	// Add query string parameters
+	params := url.Values{}
+	if s.pretty {
+		params.Set("pretty", "1")
+	}
+	if s.flatSettings != nil {
+		params.Set("flat_settings", fmt.Sprintf("%v", *s.flatSettings))
+	}
+	if s.masterTimeout != "" {
+		params.Set("master_timeout", s.masterTimeout)
+	}
+	if s.timeout != "" {
+		params.Set("timeout", s.timeout)
+	}
+	return path, params, nil
+}
+
+// Validate checks if the operation is valid.
+func (s *ClusterPutSettingsService) Validate() error {
+	if len(s.persistent) == 0 && len(s.transient) == 0 {
+		return errors.New("elastic: either Persistent or Transient settings are required in ClusterPutSettingsService")
+	}
+	return nil
+}
+
+// getBody returns the body of the request.
+func (s *ClusterPutSettingsService) getBody() interface{} {
+	body := make(map[string]interface{})
+	if len(s.persistent) > 0 {
+		body["persistent"] = s.persistent
+	}
+	if len(s.transient) > 0 {
+		body["transient"] = s.transient
+	}
+	return body
+}
+
+// Do executes the operation.
+func (s *ClusterPutSettingsService) Do(ctx context.Context) (*ClusterPutSettingsResponse, error) {
+	// Check pre-conditions
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+
+	// Get URL for request
+	path, params, err := s.buildURL()
+	if err != nil {
+		return nil, err
+	}
+
+	// Get HTTP response
+	res, err := s.client.PerformRequest(ctx, "PUT", path, params, s.getBody())
+	if err != nil {
+		return nil, err
+	}
+
+	// Return operation response
+	ret := new(ClusterPutSettingsResponse)
+	if err := s.client.decoder.Decode(res.Body, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// ClusterPutSettingsResponse is the response of ClusterPutSettingsService.Do.
+type ClusterPutSettingsResponse struct {
+	Acknowledged bool                   `json:"acknowledged"`
+	Persistent   map[string]interface{} `json:"persistent"`
+	Transient    map[string]interface{} `json:"transient"`
+}
@@ -0,0 +1,67 @@
+// Copyright 2012-present Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestClusterPutSettingsBuildURL(t *testing.T) {
+	client := setupTestClient(t)
+
+	path, params, err := client.ClusterPutSettings().FlatSettings(true).MasterTimeout("30s").buildURL()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, have := "/_cluster/settings", path; want != have {
+		t.Errorf("expected path %q; got: %q", want, have)
+	}
+	if want, have := "flat_settings=true&master_timeout=30s", params.Encode(); want != have {
+		t.Errorf("expected query %q; got: %q", want, have)
+	}
+}
+
+func TestClusterPutSettingsValidate(t *testing.T) {
+	client := setupTestClient(t)
+
+	if err := client.ClusterPutSettings().Validate(); err == nil {
+		t.Fatal("expected error when no settings are given")
+	}
+	if err := client.ClusterPutSettings().TransientSetting("cluster.routing.allocation.enable", "primaries").Validate(); err != nil {
+		t.Fatalf("expected no error; got: %v", err)
+	}
+}
+
+func TestClusterPutSettingsBody(t *testing.T) {
+	client := setupTestClient(t)
+
+	tests := []struct {
+		Service  *ClusterPutSettingsService
+		Expected string
+	}{
+		{
+			client.ClusterPutSettings().
+				Persistent(map[string]interface{}{"indices.recovery.max_bytes_per_sec": "50mb"}),
+			`{"persistent":{"indices.recovery.max_bytes_per_sec":"50mb"}}`,
+		},
+		{
+			client.ClusterPutSettings().
+				PersistentSetting("indices.recovery.max_bytes_per_sec", "50mb").
+				TransientSetting("cluster.routing.allocation.enable", nil),
+			`{"persistent":{"indices.recovery.max_bytes_per_sec":"50mb"},"transient":{"cluster.routing.allocation.enable":null}}`,
+		},
+	}
+
+	for i, test := range tests {
+		data, err := json.Marshal(test.Service.getBody())
+		if err != nil {
+			t.Fatalf("case #%d: %v", i+1, err)
+		}
+		if want, have := test.Expected, string(data); want != have {
+			t.Errorf("case #%d: expected\n%s\n,got:\n%s", i+1, want, have)
+		}
+	}
+}
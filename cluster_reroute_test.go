@@ -0,0 +1,128 @@
+// Copyright 2012-present Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+import (
+	"encoding/json"
+	"net/url"
+	"testing"
+)
+
+func TestClusterRerouteURLs(t *testing.T) {
+	tests := []struct {
+		Service        *ClusterRerouteService
+		ExpectedPath   string
+		ExpectedParams url.Values
+	}{
+		{
+			Service:        &ClusterRerouteService{},
+			ExpectedPath:   "/_cluster/reroute",
+			ExpectedParams: url.Values{},
+		},
+		{
+			Service:        (&ClusterRerouteService{}).DryRun(true),
+			ExpectedPath:   "/_cluster/reroute",
+			ExpectedParams: url.Values{"dry_run": []string{"true"}},
+		},
+		{
+			Service:        (&ClusterRerouteService{}).Explain(true).RetryFailed(true),
+			ExpectedPath:   "/_cluster/reroute",
+			ExpectedParams: url.Values{"explain": []string{"true"}, "retry_failed": []string{"true"}},
+		},
+		{
+			Service:        (&ClusterRerouteService{}).Metric("nodes", "routing_table"),
+			ExpectedPath:   "/_cluster/reroute",
+			ExpectedParams: url.Values{"metric": []string{"nodes,routing_table"}},
+		},
+	}
+
+	for i, test := range tests {
+		gotPath, gotParams, err := test.Service.buildURL()
+		if err != nil {
+			t.Fatalf("case #%d: expected no error, got %v", i+1, err)
+		}
+		if gotPath != test.ExpectedPath {
+			t.Errorf("case #%d: expected URL path = %q; got: %q", i+1, test.ExpectedPath, gotPath)
+		}
+		if gotParams.Encode() != test.ExpectedParams.Encode() {
+			t.Errorf("case #%d: expected URL params = %v; got: %v", i+1, test.ExpectedParams, gotParams)
+		}
+	}
+}
+
+func TestClusterRerouteBody(t *testing.T) {
+	tests := []struct {
+		Service  *ClusterRerouteService
+		Expected string
+	}{
+		{
+			Service: (&ClusterRerouteService{}).Add(
+				NewMoveAllocationCommand("test", 0, "node1", "node2"),
+			),
+			Expected: `{"commands":[{"move":{"from_node":"node1","index":"test","shard":0,"to_node":"node2"}}]}`,
+		},
+		{
+			Service: (&ClusterRerouteService{}).Add(
+				NewMoveAllocationCommand("test", 0, "node1", "node2"),
+				NewCancelAllocationCommand("test", 0, "node1", false),
+				NewAllocateReplicaAllocationCommand("test", 1, "node3"),
+				NewAllocateEmptyPrimaryAllocationCommand("test", 2, "node3", true),
+			),
+			Expected: `{"commands":[{"move":{"from_node":"node1","index":"test","shard":0,"to_node":"node2"}},{"cancel":{"allow_primary":false,"index":"test","node":"node1","shard":0}},{"allocate_replica":{"index":"test","node":"node3","shard":1}},{"allocate_empty_primary":{"accept_data_loss":true,"index":"test","node":"node3","shard":2}}]}`,
+		},
+		{
+			Service: (&ClusterRerouteService{}).
+				Add(NewMoveAllocationCommand("test", 0, "node1", "node2")).
+				Body(`{"commands":[]}`),
+			Expected: `"{\"commands\":[]}"`,
+		},
+	}
+
+	for i, test := range tests {
+		body, err := test.Service.getBody()
+		if err != nil {
+			t.Fatalf("case #%d: expected no error, got %v", i+1, err)
+		}
+		data, err := json.Marshal(body)
+		if err != nil {
+			t.Fatalf("case #%d: marshaling to JSON failed: %v", i+1, err)
+		}
+		if got := string(data); got != test.Expected {
+			t.Errorf("case #%d: expected\n%s\n,got:\n%s", i+1, test.Expected, got)
+		}
+	}
+}
+
+func TestClusterRerouteResponseWithExplanations(t *testing.T) {
+	body := `{
+		"acknowledged": true,
+		"explanations": [{
+			"command": "move",
+			"parameters": { "index": "test", "shard": 0, "from_node": "node1", "to_node": "node2" },
+			"decisions": [{
+				"decider": "move_allocation_command",
+				"decision": "YES",
+				"explanation": "shard has been successfully moved"
+			}]
+		}]
+	}`
+	var res ClusterRerouteResponse
+	if err := json.Unmarshal([]byte(body), &res); err != nil {
+		t.Fatal(err)
+	}
+	if want, have := 1, len(res.Explanations); want != have {
+		t.Fatalf("expected %d explanations; got: %d", want, have)
+	}
+	expl := res.Explanations[0]
+	if want, have := "move", expl.Command; want != have {
+		t.Errorf("expected command %q; got: %q", want, have)
+	}
+	if want, have := 1, len(expl.Decisions); want != have {
+		t.Fatalf("expected %d decisions; got: %d", want, have)
+	}
+	if want, have := "YES", expl.Decisions[0].Decision; want != have {
+		t.Errorf("expected decision %q; got: %q", want, have)
+	}
+}
@@ -77,6 +77,22 @@ func TestClusterStateURLs(t *testing.T) {
 			ExpectedPath:   "/_cluster/state/nodes/twitter",
 			ExpectedParams: url.Values{"master_timeout": []string{"1s"}},
 		},
+		{
+			Service: &ClusterStateService{
+				indices: []string{},
+				metrics: []string{"metadata", "routing_table"},
+			},
+			ExpectedPath: "/_cluster/state/metadata%2Crouting_table/_all",
+		},
+		{
+			Service:      NewClusterStateService(nil).Metric("metadata").Index("twitter", "gplus"),
+			ExpectedPath: "/_cluster/state/metadata/twitter%2Cgplus",
+		},
+		{
+			Service:        NewClusterStateService(nil).Metric("version", "master_node").Local(true),
+			ExpectedPath:   "/_cluster/state/version%2Cmaster_node/_all",
+			ExpectedParams: url.Values{"local": []string{"true"}},
+		},
 	}
 
 	for _, test := range tests {
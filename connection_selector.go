@@ -0,0 +1,100 @@
+// Copyright 2012-present Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+import "sync"
+
+// Connection is a connection to a single node in the cluster,
+// as passed to a ConnectionSelector.
+type Connection interface {
+	// NodeID returns the ID of the node of this connection.
+	NodeID() string
+	// URL returns the URL of this connection.
+	URL() string
+	// IsDead returns true if this connection is marked as dead.
+	IsDead() bool
+}
+
+// ConnectionSelector picks the connection to use for the next request.
+// Implementations must be safe for concurrent use. Use
+// SetConnectionSelector to replace the default round-robin strategy
+// of the Client.
+type ConnectionSelector interface {
+	// Select returns one of the connections that is not dead, or
+	// ErrNoClient if there is none. The request on the selected
+	// connection is in flight until Release is called.
+	Select(conns []Connection) (Connection, error)
+	// Release is called when the request on a connection returned
+	// by Select has finished.
+	Release(conn Connection)
+}
+
+// LeastOutstandingSelector is a ConnectionSelector that picks the
+// connection with the fewest requests in flight. Ties are broken in
+// round-robin order, so idle connections are used evenly.
+type LeastOutstandingSelector struct {
+	mu       sync.Mutex
+	inflight map[string]int // number of requests in flight by URL
+	next     int            // start index for breaking ties
+}
+
+// NewLeastOutstandingSelector creates a new LeastOutstandingSelector.
+func NewLeastOutstandingSelector() *LeastOutstandingSelector {
+	return &LeastOutstandingSelector{
+		inflight: make(map[string]int),
+	}
+}
+
+// Select returns the connection that is not dead and has the fewest
+// requests in flight.
+func (s *LeastOutstandingSelector) Select(conns []Connection) (Connection, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var (
+		best      Connection
+		bestCount int
+	)
+	numConns := len(conns)
+	for i := 0; i < numConns; i++ {
+		conn := conns[(s.next+i)%numConns]
+		if conn.IsDead() {
+			continue
+		}
+		count := s.inflight[conn.URL()]
+		if best == nil || count < bestCount {
+			best, bestCount = conn, count
+		}
+	}
+	if best == nil {
+		return nil, ErrNoClient
+	}
+	s.next++
+	if s.next >= numConns {
+		s.next = 0
+	}
+	s.inflight[best.URL()]++
+	return best, nil
+}
+
+// Release marks the request on the given connection as finished.
+func (s *LeastOutstandingSelector) Release(conn Connection) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	url := conn.URL()
+	if s.inflight[url] <= 1 {
+		delete(s.inflight, url)
+	} else {
+		s.inflight[url]--
+	}
+}
+
+// Inflight returns the number of requests in flight on the given URL.
+func (s *LeastOutstandingSelector) Inflight(url string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.inflight[url]
+}
@@ -0,0 +1,157 @@
+// Copyright 2012-present Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+)
+
+func TestLeastOutstandingSelector(t *testing.T) {
+	a := newConn("a", "http://127.0.0.1:9200")
+	b := newConn("b", "http://127.0.0.1:9201")
+	c := newConn("c", "http://127.0.0.1:9202")
+	conns := []Connection{a, b, c}
+
+	s := NewLeastOutstandingSelector()
+
+	// All idle: round-robin
+	for i, want := range []string{"a", "b", "c"} {
+		conn, err := s.Select(conns)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if have := conn.NodeID(); want != have {
+			t.Errorf("select #%d: expected %q; got: %q", i+1, want, have)
+		}
+	}
+
+	// b and c finished, a is still busy
+	s.Release(b)
+	s.Release(c)
+	for i := 0; i < 2; i++ {
+		conn, err := s.Select(conns)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if conn.NodeID() == "a" {
+			t.Errorf("select #%d: expected an idle connection; got: %q", i+1, conn.NodeID())
+		}
+	}
+	if want, have := 1, s.Inflight(a.URL()); want != have {
+		t.Errorf("expected %d requests in flight on a; got: %d", want, have)
+	}
+	if want, have := 2, s.Inflight(b.URL())+s.Inflight(c.URL()); want != have {
+		t.Errorf("expected %d requests in flight on b and c; got: %d", want, have)
+	}
+
+	// Dead connections are skipped
+	b.MarkAsDead()
+	c.MarkAsDead()
+	conn, err := s.Select(conns)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, have := "a", conn.NodeID(); want != have {
+		t.Errorf("expected %q; got: %q", want, have)
+	}
+	a.MarkAsDead()
+	if _, err := s.Select(conns); err != ErrNoClient {
+		t.Errorf("expected %v; got: %v", ErrNoClient, err)
+	}
+}
+
+func TestClientWithLeastOutstandingSelector(t *testing.T) {
+	var slowHits, fastHits int64
+	handler := func(delay time.Duration, hits *int64) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt64(hits, 1)
+			time.Sleep(delay)
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprint(w, `{}`)
+		})
+	}
+	slow := httptest.NewServer(handler(50*time.Millisecond, &slowHits))
+	defer slow.Close()
+	fast := httptest.NewServer(handler(0, &fastHits))
+	defer fast.Close()
+
+	selector := NewLeastOutstandingSelector()
+	client, err := NewClient(
+		SetURL(slow.URL, fast.URL),
+		SetSniff(false),
+		SetHealthcheck(false),
+		SetMaxRetries(0),
+		SetConnectionSelector(selector))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 10; j++ {
+				if _, err := client.PerformRequest(context.TODO(), "GET", "/", nil, nil); err != nil {
+					t.Error(err)
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	slowN, fastN := atomic.LoadInt64(&slowHits), atomic.LoadInt64(&fastHits)
+	if want, have := int64(40), slowN+fastN; want != have {
+		t.Fatalf("expected %d requests; got: %d", want, have)
+	}
+	// The slow node is busy most of the time, so the idle fast node
+	// should get the majority of requests
+	if fastN <= slowN {
+		t.Errorf("expected more requests on the fast node; got fast=%d, slow=%d", fastN, slowN)
+	}
+	// All requests have been released
+	for _, url := range []string{slow.URL, fast.URL} {
+		if n := selector.Inflight(url); n != 0 {
+			t.Errorf("expected no requests in flight on %s; got: %d", url, n)
+		}
+	}
+}
+
+func TestClientWithLeastOutstandingSelectorReleasesOnSetBodyError(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{}`)
+	}))
+	defer ts.Close()
+
+	selector := NewLeastOutstandingSelector()
+	client, err := NewClient(
+		SetURL(ts.URL),
+		SetSniff(false),
+		SetHealthcheck(false),
+		SetMaxRetries(0),
+		SetConnectionSelector(selector))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 3; i++ {
+		if _, err := client.PerformRequest(context.TODO(), "POST", "/", nil, failingBody{}); err == nil {
+			t.Fatal("expected error")
+		}
+	}
+	if n := selector.Inflight(ts.URL); n != 0 {
+		t.Errorf("expected no requests in flight; got: %d", n)
+	}
+}
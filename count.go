@@ -36,6 +36,7 @@ type CountService struct {
 	q                      string
 	query                  Query
 	routing                string
+	terminateAfter         *int
 	bodyJson               interface{}
 	bodyString             string
 }
@@ -159,6 +160,14 @@ func (s *CountService) Routing(routing string) *CountService {
 	return s
 }
 
+// TerminateAfter specifies the maximum count for each shard, upon reaching
+// which the query execution will terminate early. The resulting count is
+// then a lower bound, e.g. to cheaply check if at least N documents match.
+func (s *CountService) TerminateAfter(terminateAfter int) *CountService {
+	s.terminateAfter = &terminateAfter
+	return s
+}
+
 // Pretty indicates that the JSON response be indented and human readable.
 func (s *CountService) Pretty(pretty bool) *CountService {
 	s.pretty = pretty
@@ -249,6 +258,9 @@ func (s *CountService) buildURL() (string, url.Values, error) {
 	if s.routing != "" {
 		params.Set("routing", s.routing)
 	}
+	if s.terminateAfter != nil {
+		params.Set("terminate_after", fmt.Sprintf("%v", *s.terminateAfter))
+	}
 	return path, params, nil
 }
 
@@ -306,6 +318,7 @@ func (s *CountService) Do(ctx context.Context) (int64, error) {
 
 // CountResponse is the response of using the Count API.
 type CountResponse struct {
-	Count  int64      `json:"count"`
-	Shards shardsInfo `json:"_shards,omitempty"`
+	Count           int64      `json:"count"`
+	TerminatedEarly bool       `json:"terminated_early,omitempty"`
+	Shards          shardsInfo `json:"_shards,omitempty"`
 }
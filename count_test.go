@@ -5,6 +5,11 @@
 package elastic
 
 import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"golang.org/x/net/context"
@@ -126,3 +131,55 @@ func TestCount(t *testing.T) {
 		t.Errorf("expected Count = %d; got %d", 2, count)
 	}
 }
+
+func TestCountTerminateAfterAndMinScore(t *testing.T) {
+	var query, body string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			t.Error(err)
+		}
+		query, body = r.URL.RawQuery, string(data)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"count":5,"terminated_early":true,"_shards":{"total":1,"successful":1,"failed":0}}`)
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(SetURL(ts.URL), SetSniff(false), SetHealthcheck(false), SetMaxRetries(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := client.Count("twitter").
+		Query(NewTermQuery("user", "olivere")).
+		TerminateAfter(5).
+		MinScore(0.5).
+		Do(context.TODO())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, have := "min_score=0.5&terminate_after=5", query; want != have {
+		t.Errorf("expected query string %q; got: %q", want, have)
+	}
+	if want, have := `{"query":{"term":{"user":"olivere"}}}`, body; want != have {
+		t.Errorf("expected body %s; got: %s", want, have)
+	}
+	// With terminate_after, the count is capped per shard
+	if want, have := int64(5), count; want != have {
+		t.Errorf("expected count = %d; got: %d", want, have)
+	}
+}
+
+func TestCountResponseTerminatedEarly(t *testing.T) {
+	var res CountResponse
+	err := json.Unmarshal([]byte(`{"count":5,"terminated_early":true,"_shards":{"total":1,"successful":1,"failed":0}}`), &res)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, have := int64(5), res.Count; want != have {
+		t.Errorf("expected count = %d; got: %d", want, have)
+	}
+	if !res.TerminatedEarly {
+		t.Error("expected terminated_early = true")
+	}
+}
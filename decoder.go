@@ -5,7 +5,9 @@
 package elastic
 
 import (
+	"bytes"
 	"encoding/json"
+	"io"
 )
 
 // Decoder is used to decode responses from Elasticsearch.
@@ -16,6 +18,15 @@ type Decoder interface {
 	Decode(data []byte, v interface{}) error
 }
 
+// ReaderDecoder is a Decoder that can also decode directly from a
+// stream. Large responses, e.g. of searches and scrolls, are decoded
+// with DecodeReader if the Decoder of the Client implements it, which
+// saves reading the whole response into memory before decoding it.
+type ReaderDecoder interface {
+	Decoder
+	DecodeReader(r io.Reader, v interface{}) error
+}
+
 // DefaultDecoder uses json.Unmarshal from the Go standard library
 // to decode JSON data.
 type DefaultDecoder struct{}
@@ -24,3 +35,17 @@ type DefaultDecoder struct{}
 func (u *DefaultDecoder) Decode(data []byte, v interface{}) error {
 	return json.Unmarshal(data, v)
 }
+
+// StreamDecoder uses json.Decoder from the Go standard library to
+// decode JSON data directly from the response body.
+type StreamDecoder struct{}
+
+// Decode decodes with json.Decoder from the Go standard library.
+func (u *StreamDecoder) Decode(data []byte, v interface{}) error {
+	return u.DecodeReader(bytes.NewReader(data), v)
+}
+
+// DecodeReader decodes with json.Decoder from the Go standard library.
+func (u *StreamDecoder) DecodeReader(r io.Reader, v interface{}) error {
+	return json.NewDecoder(r).Decode(v)
+}
@@ -7,6 +7,11 @@ package elastic
 import (
 	"bytes"
 	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
 	"sync/atomic"
 	"testing"
 
@@ -49,3 +54,79 @@ func TestDecoder(t *testing.T) {
 		t.Errorf("expected at least 1 call of decoder; got: %d", dec.N)
 	}
 }
+
+type readerDecoder struct {
+	mu      sync.Mutex
+	readers []io.Reader
+	N       int64
+}
+
+func (d *readerDecoder) Decode(data []byte, v interface{}) error {
+	atomic.AddInt64(&d.N, 1)
+	return json.Unmarshal(data, v)
+}
+
+func (d *readerDecoder) DecodeReader(r io.Reader, v interface{}) error {
+	d.mu.Lock()
+	d.readers = append(d.readers, r)
+	d.mu.Unlock()
+	return json.NewDecoder(r).Decode(v)
+}
+
+func TestReaderDecoder(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"took":1,"_scroll_id":"1","hits":{"total":1,"hits":[{"_index":"twitter","_type":"tweet","_id":"1","_source":{"user":"olivere"}}]}}`)
+	}))
+	defer ts.Close()
+
+	dec := &readerDecoder{}
+	client, err := NewClient(SetURL(ts.URL), SetSniff(false), SetHealthcheck(false), SetMaxRetries(0), SetDecoder(dec))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := client.Search().Index("twitter").Do(context.TODO())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, have := int64(1), res.TotalHits(); want != have {
+		t.Errorf("expected %d hits; got: %d", want, have)
+	}
+	res, err = client.Scroll("twitter").Do(context.TODO())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, have := 1, len(res.Hits.Hits); want != have {
+		t.Errorf("expected %d hits; got: %d", want, have)
+	}
+
+	dec.mu.Lock()
+	defer dec.mu.Unlock()
+	if want, have := 2, len(dec.readers); want != have {
+		t.Fatalf("expected %d calls of DecodeReader; got: %d", want, have)
+	}
+	for i, r := range dec.readers {
+		// The decoder must get the response body, not a buffered copy of it
+		if _, ok := r.(*bytes.Reader); ok {
+			t.Errorf("call #%d: expected a streaming reader; got: %T", i+1, r)
+		}
+	}
+	if dec.N != 0 {
+		t.Errorf("expected no calls of Decode; got: %d", dec.N)
+	}
+}
+
+func TestStreamDecoder(t *testing.T) {
+	dec := &StreamDecoder{}
+	var res SearchResult
+	if err := dec.Decode([]byte(`{"took":3,"hits":{"total":2,"hits":[]}}`), &res); err != nil {
+		t.Fatal(err)
+	}
+	if want, have := int64(3), res.TookInMillis; want != have {
+		t.Errorf("expected TookInMillis = %d; got: %d", want, have)
+	}
+	if want, have := int64(2), res.TotalHits(); want != have {
+		t.Errorf("expected %d hits; got: %d", want, have)
+	}
+}
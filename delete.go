@@ -98,7 +98,11 @@ func (s *DeleteService) Parent(parent string) *DeleteService {
 	return s
 }
 
-// Refresh the index after performing the operation.
+// Refresh controls when changes made by this request are made visible
+// to search. The allowed values are: "true" (refresh the relevant
+// primary and replica shards immediately), "wait_for" (wait for the
+// changes to be made visible by a refresh before applying), or "false"
+// (no refresh related actions).
 func (s *DeleteService) Refresh(refresh string) *DeleteService {
 	s.refresh = refresh
 	return s
@@ -166,6 +170,9 @@ func (s *DeleteService) Validate() error {
 	if len(invalid) > 0 {
 		return fmt.Errorf("missing required fields: %v", invalid)
 	}
+	if err := validateRefresh(s.refresh); err != nil {
+		return err
+	}
 	return nil
 }
 
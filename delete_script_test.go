@@ -0,0 +1,19 @@
+// Copyright 2012-present Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+import "testing"
+
+func TestDeleteScriptURL(t *testing.T) {
+	client := setupTestClient(t)
+
+	path, _, err := client.DeleteScript().Id("my-script").buildURL()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, have := "/_scripts/my-script", path; want != have {
+		t.Errorf("expected %q; got: %q", want, have)
+	}
+}
@@ -118,3 +118,24 @@ func TestDeleteValidate(t *testing.T) {
 		t.Fatalf("expected result to be == nil; got: %v", res)
 	}
 }
+
+func TestDeleteRefresh(t *testing.T) {
+	client := setupTestClient(t)
+
+	svc := NewDeleteService(client).Index(testIndexName).Type("tweet").Id("1").Refresh("wait_for")
+	if err := svc.Validate(); err != nil {
+		t.Fatalf("expected Delete to accept refresh=wait_for; got: %v", err)
+	}
+	_, params, err := svc.buildURL()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, have := "wait_for", params.Get("refresh"); want != have {
+		t.Errorf("expected refresh=%q; got: %q", want, have)
+	}
+
+	svc = NewDeleteService(client).Index(testIndexName).Type("tweet").Id("1").Refresh("yes")
+	if err := svc.Validate(); err == nil {
+		t.Fatalf("expected Delete to fail with invalid refresh value")
+	}
+}
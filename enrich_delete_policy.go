@@ -0,0 +1,104 @@
+// Copyright 2012-present Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+import (
+	"fmt"
+	"net/url"
+
+	"golang.org/x/net/context"
+
+	"gopkg.in/olivere/elastic.v5/uritemplates"
+)
+
+// EnrichDeletePolicyService deletes an enrich policy and its enrich index.
+//
+// It is documented at https://www.elastic.co/guide/en/elasticsearch/reference/7.5/delete-enrich-policy-api.html.
+type EnrichDeletePolicyService struct {
+	client *Client
+	pretty bool
+	name   string
+}
+
+// NewEnrichDeletePolicyService creates a new EnrichDeletePolicyService.
+func NewEnrichDeletePolicyService(client *Client) *EnrichDeletePolicyService {
+	return &EnrichDeletePolicyService{
+		client: client,
+	}
+}
+
+// Name is the name of the enrich policy.
+func (s *EnrichDeletePolicyService) Name(name string) *EnrichDeletePolicyService {
+	s.name = name
+	return s
+}
+
+// Pretty indicates that the JSON response be indented and human readable.
+func (s *EnrichDeletePolicyService) Pretty(pretty bool) *EnrichDeletePolicyService {
+	s.pretty = pretty
+	return s
+}
+
+// buildURL builds the URL for the operation.
+func (s *EnrichDeletePolicyService) buildURL() (string, url.Values, error) {
+	// Build URL
+	path, err := uritemplates.Expand("/_enrich/policy/{name}", map[string]string{
+		"name": s.name,
+	})
+	if err != nil {
+		return "", url.Values{}, err
+	}
+
+	// Add query string parameters
+	params := url.Values{}
+	if s.pretty {
+		params.Set("pretty", "1")
+	}
+	return path, params, nil
+}
+
+// Validate checks if the operation is valid.
+func (s *EnrichDeletePolicyService) Validate() error {
+	var invalid []string
+	if s.name == "" {
+		invalid = append(invalid, "Name")
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf("missing required fields: %v", invalid)
+	}
+	return nil
+}
+
+// Do executes the operation.
+func (s *EnrichDeletePolicyService) Do(ctx context.Context) (*EnrichDeletePolicyResponse, error) {
+	// Check pre-conditions
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+
+	// Get URL for request
+	path, params, err := s.buildURL()
+	if err != nil {
+		return nil, err
+	}
+
+	// Get HTTP response
+	res, err := s.client.PerformRequest(ctx, "DELETE", path, params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return operation response
+	ret := new(EnrichDeletePolicyResponse)
+	if err := s.client.decoder.Decode(res.Body, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// EnrichDeletePolicyResponse is the response of EnrichDeletePolicyService.Do.
+type EnrichDeletePolicyResponse struct {
+	Acknowledged bool `json:"acknowledged"`
+}
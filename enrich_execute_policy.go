@@ -0,0 +1,124 @@
+// Copyright 2012-present Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+import (
+	"fmt"
+	"net/url"
+
+	"golang.org/x/net/context"
+
+	"gopkg.in/olivere/elastic.v5/uritemplates"
+)
+
+// EnrichExecutePolicyService executes an enrich policy, i.e. it creates
+// the enrich index for the policy.
+//
+// It is documented at https://www.elastic.co/guide/en/elasticsearch/reference/7.5/execute-enrich-policy-api.html.
+type EnrichExecutePolicyService struct {
+	client            *Client
+	pretty            bool
+	name              string
+	waitForCompletion *bool
+}
+
+// NewEnrichExecutePolicyService creates a new EnrichExecutePolicyService.
+func NewEnrichExecutePolicyService(client *Client) *EnrichExecutePolicyService {
+	return &EnrichExecutePolicyService{
+		client: client,
+	}
+}
+
+// Name is the name of the enrich policy.
+func (s *EnrichExecutePolicyService) Name(name string) *EnrichExecutePolicyService {
+	s.name = name
+	return s
+}
+
+// WaitForCompletion indicates whether the request blocks until the
+// execution is complete (default: true). If false, the response contains
+// the ID of the task that executes the policy.
+func (s *EnrichExecutePolicyService) WaitForCompletion(waitForCompletion bool) *EnrichExecutePolicyService {
+	s.waitForCompletion = &waitForCompletion
+	return s
+}
+
+// Pretty indicates that the JSON response be indented and human readable.
+func (s *EnrichExecutePolicyService) Pretty(pretty bool) *EnrichExecutePolicyService {
+	s.pretty = pretty
+	return s
+}
+
+// buildURL builds the URL for the operation.
+func (s *EnrichExecutePolicyService) buildURL() (string, url.Values, error) {
+	// Build URL
+	path, err := uritemplates.Expand("/_enrich/policy/{name}/_execute", map[string]string{
+		"name": s.name,
+	})
+	if err != nil {
+		return "", url.Values{}, err
+	}
+
+	// Add query string parameters
+	params := url.Values{}
+	if s.pretty {
+		params.Set("pretty", "1")
+	}
+	if s.waitForCompletion != nil {
+		params.Set("wait_for_completion", fmt.Sprintf("%v", *s.waitForCompletion))
+	}
+	return path, params, nil
+}
+
+// Validate checks if the operation is valid.
+func (s *EnrichExecutePolicyService) Validate() error {
+	var invalid []string
+	if s.name == "" {
+		invalid = append(invalid, "Name")
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf("missing required fields: %v", invalid)
+	}
+	return nil
+}
+
+// Do executes the operation.
+func (s *EnrichExecutePolicyService) Do(ctx context.Context) (*EnrichExecutePolicyResponse, error) {
+	// Check pre-conditions
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+
+	// Get URL for request
+	path, params, err := s.buildURL()
+	if err != nil {
+		return nil, err
+	}
+
+	// Get HTTP response
+	res, err := s.client.PerformRequest(ctx, "PUT", path, params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return operation response
+	ret := new(EnrichExecutePolicyResponse)
+	if err := s.client.decoder.Decode(res.Body, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// EnrichExecutePolicyResponse is the response of EnrichExecutePolicyService.Do.
+// Status is set when waiting for completion, Task otherwise.
+type EnrichExecutePolicyResponse struct {
+	Status *EnrichExecutePolicyStatus `json:"status,omitempty"`
+	Task   string                     `json:"task,omitempty"`
+}
+
+// EnrichExecutePolicyStatus is the status of an executed enrich policy.
+type EnrichExecutePolicyStatus struct {
+	Phase string `json:"phase"` // e.g. COMPLETE
+}
@@ -0,0 +1,51 @@
+// Copyright 2012-present Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnrichExecutePolicyURL(t *testing.T) {
+	client := setupTestClient(t)
+
+	path, params, err := client.EnrichExecutePolicy("users-policy").buildURL()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, have := "/_enrich/policy/users-policy/_execute", path; want != have {
+		t.Errorf("expected %q; got: %q", want, have)
+	}
+	if want, have := "", params.Get("wait_for_completion"); want != have {
+		t.Errorf("expected wait_for_completion = %q; got: %q", want, have)
+	}
+
+	_, params, err = client.EnrichExecutePolicy("users-policy").WaitForCompletion(false).buildURL()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, have := "false", params.Get("wait_for_completion"); want != have {
+		t.Errorf("expected wait_for_completion = %q; got: %q", want, have)
+	}
+}
+
+func TestEnrichExecutePolicyResponse(t *testing.T) {
+	var res EnrichExecutePolicyResponse
+	if err := json.Unmarshal([]byte(`{"status":{"phase":"COMPLETE"}}`), &res); err != nil {
+		t.Fatal(err)
+	}
+	if res.Status == nil || res.Status.Phase != "COMPLETE" {
+		t.Errorf("expected phase %q; got: %v", "COMPLETE", res.Status)
+	}
+
+	res = EnrichExecutePolicyResponse{}
+	if err := json.Unmarshal([]byte(`{"task":"oTUltX4IQMOUUVeiohTt8A:123"}`), &res); err != nil {
+		t.Fatal(err)
+	}
+	if want, have := "oTUltX4IQMOUUVeiohTt8A:123", res.Task; want != have {
+		t.Errorf("expected task %q; got: %q", want, have)
+	}
+}
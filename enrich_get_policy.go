@@ -0,0 +1,120 @@
+// Copyright 2012-present Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+import (
+	"net/url"
+	"strings"
+
+	"golang.org/x/net/context"
+
+	"gopkg.in/olivere/elastic.v5/uritemplates"
+)
+
+// EnrichGetPolicyService returns information about enrich policies.
+// Without names, it returns all enrich policies.
+//
+// It is documented at https://www.elastic.co/guide/en/elasticsearch/reference/7.5/get-enrich-policy-api.html.
+type EnrichGetPolicyService struct {
+	client *Client
+	pretty bool
+	name   []string
+}
+
+// NewEnrichGetPolicyService creates a new EnrichGetPolicyService.
+func NewEnrichGetPolicyService(client *Client) *EnrichGetPolicyService {
+	return &EnrichGetPolicyService{
+		client: client,
+	}
+}
+
+// Name is a list of enrich policy names.
+func (s *EnrichGetPolicyService) Name(name ...string) *EnrichGetPolicyService {
+	s.name = append(s.name, name...)
+	return s
+}
+
+// Pretty indicates that the JSON response be indented and human readable.
+func (s *EnrichGetPolicyService) Pretty(pretty bool) *EnrichGetPolicyService {
+	s.pretty = pretty
+	return s
+}
+
+// buildURL builds the URL for the operation.
+func (s *EnrichGetPolicyService) buildURL() (string, url.Values, error) {
+	var err error
+	var path string
+
+	// Build URL
+	if len(s.name) > 0 {
+		path, err = uritemplates.Expand("/_enrich/policy/{name}", map[string]string{
+			"name": strings.Join(s.name, ","),
+		})
+	} else {
+		path = "/_enrich/policy"
+	}
+	if err != nil {
+		return "", url.Values{}, err
+	}
+
+	// Add query string parameters
+	params := url.Values{}
+	if s.pretty {
+		params.Set("pretty", "1")
+	}
+	return path, params, nil
+}
+
+// Validate checks if the operation is valid.
+func (s *EnrichGetPolicyService) Validate() error {
+	return nil
+}
+
+// Do executes the operation.
+func (s *EnrichGetPolicyService) Do(ctx context.Context) (*EnrichGetPolicyResponse, error) {
+	// Check pre-conditions
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+
+	// Get URL for request
+	path, params, err := s.buildURL()
+	if err != nil {
+		return nil, err
+	}
+
+	// Get HTTP response
+	res, err := s.client.PerformRequest(ctx, "GET", path, params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return operation response
+	ret := new(EnrichGetPolicyResponse)
+	if err := s.client.decoder.Decode(res.Body, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// EnrichGetPolicyResponse is the response of EnrichGetPolicyService.Do.
+type EnrichGetPolicyResponse struct {
+	Policies []*EnrichGetPolicy `json:"policies"`
+}
+
+// EnrichGetPolicy is a single policy returned by EnrichGetPolicyService.
+// Config is keyed by the policy type, i.e. "match", "geo_match", or "range".
+type EnrichGetPolicy struct {
+	Config map[string]*EnrichGetPolicyConfig `json:"config"`
+}
+
+// EnrichGetPolicyConfig is the configuration of an enrich policy.
+type EnrichGetPolicyConfig struct {
+	Name         string                 `json:"name"`
+	Indices      []string               `json:"indices"`
+	MatchField   string                 `json:"match_field"`
+	EnrichFields []string               `json:"enrich_fields"`
+	Query        map[string]interface{} `json:"query,omitempty"`
+}
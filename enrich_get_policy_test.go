@@ -0,0 +1,78 @@
+// Copyright 2012-present Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnrichGetPolicyURL(t *testing.T) {
+	client := setupTestClient(t)
+
+	tests := []struct {
+		Names    []string
+		Expected string
+	}{
+		{
+			[]string{},
+			"/_enrich/policy",
+		},
+		{
+			[]string{"users-policy"},
+			"/_enrich/policy/users-policy",
+		},
+		{
+			[]string{"users-policy", "postal-policy"},
+			"/_enrich/policy/users-policy%2Cpostal-policy",
+		},
+	}
+
+	for i, test := range tests {
+		path, _, err := client.EnrichGetPolicy(test.Names...).buildURL()
+		if err != nil {
+			t.Errorf("case #%d: %v", i+1, err)
+			continue
+		}
+		if path != test.Expected {
+			t.Errorf("case #%d: expected %q; got: %q", i+1, test.Expected, path)
+		}
+	}
+}
+
+func TestEnrichGetPolicyResponse(t *testing.T) {
+	body := `{
+		"policies": [{
+			"config": {
+				"match": {
+					"name": "users-policy",
+					"indices": ["users"],
+					"match_field": "email",
+					"enrich_fields": ["first_name", "last_name"]
+				}
+			}
+		}]
+	}`
+	var res EnrichGetPolicyResponse
+	if err := json.Unmarshal([]byte(body), &res); err != nil {
+		t.Fatal(err)
+	}
+	if want, have := 1, len(res.Policies); want != have {
+		t.Fatalf("expected %d policies; got: %d", want, have)
+	}
+	config, found := res.Policies[0].Config["match"]
+	if !found || config == nil {
+		t.Fatalf("expected a match policy; got: %v", res.Policies[0].Config)
+	}
+	if want, have := "users-policy", config.Name; want != have {
+		t.Errorf("expected name %q; got: %q", want, have)
+	}
+	if want, have := "email", config.MatchField; want != have {
+		t.Errorf("expected match_field %q; got: %q", want, have)
+	}
+	if want, have := 2, len(config.EnrichFields); want != have {
+		t.Errorf("expected %d enrich fields; got: %d", want, have)
+	}
+}
@@ -0,0 +1,233 @@
+// Copyright 2012-present Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+import (
+	"fmt"
+	"net/url"
+
+	"golang.org/x/net/context"
+
+	"gopkg.in/olivere/elastic.v5/uritemplates"
+)
+
+// EnrichPutPolicyService creates an enrich policy.
+//
+// It is documented at https://www.elastic.co/guide/en/elasticsearch/reference/7.5/put-enrich-policy-api.html.
+type EnrichPutPolicyService struct {
+	client     *Client
+	pretty     bool
+	name       string
+	policyType string
+	policy     *EnrichPolicy
+	bodyJson   interface{}
+	bodyString string
+}
+
+// NewEnrichPutPolicyService creates a new EnrichPutPolicyService.
+func NewEnrichPutPolicyService(client *Client) *EnrichPutPolicyService {
+	return &EnrichPutPolicyService{
+		client: client,
+	}
+}
+
+// Name is the name of the enrich policy.
+func (s *EnrichPutPolicyService) Name(name string) *EnrichPutPolicyService {
+	s.name = name
+	return s
+}
+
+// Match sets a policy that matches enrich data to incoming documents
+// based on a term query.
+func (s *EnrichPutPolicyService) Match(policy *EnrichPolicy) *EnrichPutPolicyService {
+	s.policyType = "match"
+	s.policy = policy
+	return s
+}
+
+// GeoMatch sets a policy that matches enrich data to incoming documents
+// based on a geo_shape query.
+func (s *EnrichPutPolicyService) GeoMatch(policy *EnrichPolicy) *EnrichPutPolicyService {
+	s.policyType = "geo_match"
+	s.policy = policy
+	return s
+}
+
+// Range sets a policy that matches a number, date, or IP address in
+// incoming documents to a range in the enrich index.
+func (s *EnrichPutPolicyService) Range(policy *EnrichPolicy) *EnrichPutPolicyService {
+	s.policyType = "range"
+	s.policy = policy
+	return s
+}
+
+// Pretty indicates that the JSON response be indented and human readable.
+func (s *EnrichPutPolicyService) Pretty(pretty bool) *EnrichPutPolicyService {
+	s.pretty = pretty
+	return s
+}
+
+// BodyJson is the policy definition, defined as a JSON-serializable document.
+// Use e.g. a map[string]interface{} here.
+func (s *EnrichPutPolicyService) BodyJson(body interface{}) *EnrichPutPolicyService {
+	s.bodyJson = body
+	return s
+}
+
+// BodyString is the policy definition, specified as a string.
+func (s *EnrichPutPolicyService) BodyString(body string) *EnrichPutPolicyService {
+	s.bodyString = body
+	return s
+}
+
+// buildURL builds the URL for the operation.
+func (s *EnrichPutPolicyService) buildURL() (string, url.Values, error) {
+	// Build URL
+	path, err := uritemplates.Expand("/_enrich/policy/{name}", map[string]string{
+		"name": s.name,
+	})
+	if err != nil {
+		return "", url.Values{}, err
+	}
+
+	// Add query string parameters
+	params := url.Values{}
+	if s.pretty {
+		params.Set("pretty", "1")
+	}
+	return path, params, nil
+}
+
+// Validate checks if the operation is valid.
+func (s *EnrichPutPolicyService) Validate() error {
+	var invalid []string
+	if s.name == "" {
+		invalid = append(invalid, "Name")
+	}
+	if s.bodyString == "" && s.bodyJson == nil && s.policy == nil {
+		invalid = append(invalid, "BodyJson")
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf("missing required fields: %v", invalid)
+	}
+	return nil
+}
+
+// getBody returns the body of the request.
+func (s *EnrichPutPolicyService) getBody() (interface{}, error) {
+	if s.bodyJson != nil {
+		return s.bodyJson, nil
+	}
+	if s.bodyString != "" {
+		return s.bodyString, nil
+	}
+	src, err := s.policy.Source()
+	if err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{
+		s.policyType: src,
+	}, nil
+}
+
+// Do executes the operation.
+func (s *EnrichPutPolicyService) Do(ctx context.Context) (*EnrichPutPolicyResponse, error) {
+	// Check pre-conditions
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+
+	// Get URL for request
+	path, params, err := s.buildURL()
+	if err != nil {
+		return nil, err
+	}
+
+	// Setup HTTP request body
+	body, err := s.getBody()
+	if err != nil {
+		return nil, err
+	}
+
+	// Get HTTP response
+	res, err := s.client.PerformRequest(ctx, "PUT", path, params, body)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return operation response
+	ret := new(EnrichPutPolicyResponse)
+	if err := s.client.decoder.Decode(res.Body, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// EnrichPutPolicyResponse is the response of EnrichPutPolicyService.Do.
+type EnrichPutPolicyResponse struct {
+	Acknowledged bool `json:"acknowledged"`
+}
+
+// -- Policy --
+
+// EnrichPolicy specifies the source indices and fields of an enrich policy.
+// Use it with EnrichPutPolicyService.Match, GeoMatch, or Range.
+type EnrichPolicy struct {
+	indices      []string
+	matchField   string
+	enrichFields []string
+	query        Query
+}
+
+// NewEnrichPolicy creates a new EnrichPolicy.
+func NewEnrichPolicy() *EnrichPolicy {
+	return &EnrichPolicy{}
+}
+
+// Indices are the source indices used to create the enrich index.
+func (p *EnrichPolicy) Indices(indices ...string) *EnrichPolicy {
+	p.indices = append(p.indices, indices...)
+	return p
+}
+
+// MatchField is the field in the source indices used to match
+// incoming documents.
+func (p *EnrichPolicy) MatchField(matchField string) *EnrichPolicy {
+	p.matchField = matchField
+	return p
+}
+
+// EnrichFields are the fields added to matching incoming documents.
+func (p *EnrichPolicy) EnrichFields(enrichFields ...string) *EnrichPolicy {
+	p.enrichFields = append(p.enrichFields, enrichFields...)
+	return p
+}
+
+// Query is used to filter documents in the enrich index.
+func (p *EnrichPolicy) Query(query Query) *EnrichPolicy {
+	p.query = query
+	return p
+}
+
+// Source returns the serializable JSON for the policy.
+func (p *EnrichPolicy) Source() (interface{}, error) {
+	// {
+	//   "indices": "users",
+	//   "match_field": "email",
+	//   "enrich_fields": ["first_name", "last_name", "city"]
+	// }
+	source := make(map[string]interface{})
+	source["indices"] = p.indices
+	source["match_field"] = p.matchField
+	source["enrich_fields"] = p.enrichFields
+	if p.query != nil {
+		src, err := p.query.Source()
+		if err != nil {
+			return nil, err
+		}
+		source["query"] = src
+	}
+	return source, nil
+}
@@ -0,0 +1,90 @@
+// Copyright 2012-present Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnrichPutPolicyURL(t *testing.T) {
+	client := setupTestClient(t)
+
+	path, _, err := client.EnrichPutPolicy("users-policy").buildURL()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, have := "/_enrich/policy/users-policy", path; want != have {
+		t.Errorf("expected %q; got: %q", want, have)
+	}
+}
+
+func TestEnrichPutPolicyBody(t *testing.T) {
+	client := setupTestClient(t)
+
+	tests := []struct {
+		Service  *EnrichPutPolicyService
+		Expected string
+	}{
+		{
+			client.EnrichPutPolicy("users-policy").Match(
+				NewEnrichPolicy().
+					Indices("users").
+					MatchField("email").
+					EnrichFields("first_name", "last_name", "city"),
+			),
+			`{"match":{"enrich_fields":["first_name","last_name","city"],"indices":["users"],"match_field":"email"}}`,
+		},
+		{
+			client.EnrichPutPolicy("postal-policy").GeoMatch(
+				NewEnrichPolicy().
+					Indices("postal_codes").
+					MatchField("location").
+					EnrichFields("location", "postal_code"),
+			),
+			`{"geo_match":{"enrich_fields":["location","postal_code"],"indices":["postal_codes"],"match_field":"location"}}`,
+		},
+		{
+			client.EnrichPutPolicy("networks-policy").Range(
+				NewEnrichPolicy().
+					Indices("networks").
+					MatchField("range").
+					EnrichFields("name", "department").
+					Query(NewTermQuery("active", true)),
+			),
+			`{"range":{"enrich_fields":["name","department"],"indices":["networks"],"match_field":"range","query":{"term":{"active":true}}}}`,
+		},
+	}
+
+	for i, test := range tests {
+		if err := test.Service.Validate(); err != nil {
+			t.Errorf("case #%d: %v", i+1, err)
+			continue
+		}
+		body, err := test.Service.getBody()
+		if err != nil {
+			t.Errorf("case #%d: %v", i+1, err)
+			continue
+		}
+		data, err := json.Marshal(body)
+		if err != nil {
+			t.Fatalf("case #%d: marshaling to JSON failed: %v", i+1, err)
+		}
+		if got := string(data); got != test.Expected {
+			t.Errorf("case #%d: expected\n%s\n,got:\n%s", i+1, test.Expected, got)
+		}
+	}
+}
+
+func TestEnrichPutPolicyValidate(t *testing.T) {
+	client := setupTestClient(t)
+
+	if err := client.EnrichPutPolicy("users-policy").Validate(); err == nil {
+		t.Error("expected Validate to fail without a policy")
+	}
+	if err := client.EnrichPutPolicy("").Match(NewEnrichPolicy()).Validate(); err == nil {
+		t.Error("expected Validate to fail without a name")
+	}
+}
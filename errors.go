@@ -9,6 +9,9 @@ import (
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"strconv"
+	"strings"
+	"time"
 )
 
 // checkResponse will return an error if the request/response indicates
@@ -35,6 +38,20 @@ func checkResponse(req *http.Request, res *http.Response, ignoreErrors ...int) e
 	return createResponseError(res)
 }
 
+// checkProductHeader returns an error if the HTTP response from the server
+// at url does not carry the X-Elastic-Product header sent by Elasticsearch
+// 7.14 and later.
+func checkProductHeader(url string, res *http.Response) error {
+	product := res.Header.Get("X-Elastic-Product")
+	if product == "" {
+		return fmt.Errorf("elastic: the server at %s did not send the X-Elastic-Product header and is not a supported Elasticsearch product", url)
+	}
+	if product != "Elasticsearch" {
+		return fmt.Errorf("elastic: the server at %s is %q, not a supported Elasticsearch product", url, product)
+	}
+	return nil
+}
+
 // createResponseError creates an Error structure from the HTTP response,
 // its status code and the error information sent by Elasticsearch.
 func createResponseError(res *http.Response) error {
@@ -48,21 +65,48 @@ func createResponseError(res *http.Response) error {
 	errReply := new(Error)
 	err = json.Unmarshal(data, errReply)
 	if err != nil {
-		return &Error{Status: res.StatusCode}
+		return &Error{Status: res.StatusCode, RetryAfter: parseRetryAfter(res.Header.Get("Retry-After"), time.Now())}
 	}
 	if errReply != nil {
 		if errReply.Status == 0 {
 			errReply.Status = res.StatusCode
 		}
+		errReply.RetryAfter = parseRetryAfter(res.Header.Get("Retry-After"), time.Now())
 		return errReply
 	}
 	return &Error{Status: res.StatusCode}
 }
 
+// parseRetryAfter parses the value of a Retry-After header, which is
+// either a number of seconds or an HTTP date. It returns 0 if the value
+// is empty or invalid, or if the date is not after now.
+func parseRetryAfter(value string, now time.Time) time.Duration {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0
+		}
+		return time.Duration(seconds) * time.Second
+	}
+	if t, err := http.ParseTime(value); err == nil {
+		if d := t.Sub(now); d > 0 {
+			return d
+		}
+	}
+	return 0
+}
+
 // Error encapsulates error details as returned from Elasticsearch.
 type Error struct {
 	Status  int           `json:"status"`
 	Details *ErrorDetails `json:"error,omitempty"`
+
+	// RetryAfter is the delay requested by the Retry-After header of the
+	// response, e.g. with HTTP status 429. It is 0 if the header is missing.
+	RetryAfter time.Duration `json:"-"`
 }
 
 // ErrorDetails encapsulate error details from Elasticsearch.
@@ -123,6 +167,17 @@ func IsTimeout(err interface{}) bool {
 	return false
 }
 
+// IsTooManyRequests returns true if the given error indicates that
+// Elasticsearch returned HTTP status 429, e.g. because it rejected the
+// request with an es_rejected_execution_exception. It also returns the
+// delay requested by the Retry-After header of the response, if any.
+func IsTooManyRequests(err error) (time.Duration, bool) {
+	if e, ok := err.(*Error); ok && e.Status == http.StatusTooManyRequests {
+		return e.RetryAfter, true
+	}
+	return 0, false
+}
+
 // -- General errors --
 
 // shardsInfo represents information from a shard.
@@ -132,6 +187,25 @@ type shardsInfo struct {
 	Failed     int `json:"failed"`
 }
 
+// ShardsInfo represents information about the shards involved in a
+// request, including the failures of individual shards.
+type ShardsInfo struct {
+	Total      int             `json:"total"`
+	Successful int             `json:"successful"`
+	Skipped    int             `json:"skipped,omitempty"`
+	Failed     int             `json:"failed"`
+	Failures   []*ShardFailure `json:"failures,omitempty"`
+}
+
+// ShardFailure represents the failure of a single shard.
+type ShardFailure struct {
+	Index  string        `json:"index,omitempty"`
+	Shard  int           `json:"shard"`
+	Node   string        `json:"node,omitempty"`
+	Status string        `json:"status,omitempty"`
+	Reason *ErrorDetails `json:"reason,omitempty"`
+}
+
 // shardOperationFailure represents a shard failure.
 type shardOperationFailure struct {
 	Shard  int    `json:"shard"`
@@ -2,10 +2,12 @@ package elastic
 
 import (
 	"bufio"
+	"errors"
 	"fmt"
 	"net/http"
 	"strings"
 	"testing"
+	"time"
 )
 
 func TestResponseError(t *testing.T) {
@@ -200,3 +202,73 @@ func TestIsTimeout(t *testing.T) {
 		t.Errorf("expected %v; got: %v", want, got)
 	}
 }
+
+func TestResponseErrorTooManyRequests(t *testing.T) {
+	raw := "HTTP/1.1 429 Too Many Requests\r\n" +
+		"Retry-After: 30\r\n" +
+		"\r\n" +
+		`{"error":{"root_cause":[{"type":"es_rejected_execution_exception","reason":"rejected execution"}],"type":"es_rejected_execution_exception","reason":"rejected execution"},"status":429}` + "\r\n"
+	r := bufio.NewReader(strings.NewReader(raw))
+
+	req, err := http.NewRequest("GET", "/", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := http.ReadResponse(r, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = checkResponse(req, resp)
+	if err == nil {
+		t.Fatalf("expected error; got: %v", err)
+	}
+
+	retryAfter, ok := IsTooManyRequests(err)
+	if !ok {
+		t.Fatalf("expected IsTooManyRequests to return true for %v", err)
+	}
+	if want, have := 30*time.Second, retryAfter; want != have {
+		t.Errorf("expected Retry-After of %v; got: %v", want, have)
+	}
+	e := err.(*Error)
+	if e.Details == nil {
+		t.Fatalf("expected error details; got: %v", e.Details)
+	}
+	if want, have := "es_rejected_execution_exception", e.Details.Type; want != have {
+		t.Errorf("expected error details type %q; got: %q", want, have)
+	}
+
+	// Other errors are not too many requests
+	if _, ok := IsTooManyRequests(&Error{Status: http.StatusServiceUnavailable}); ok {
+		t.Error("expected IsTooManyRequests to return false for 503")
+	}
+	if _, ok := IsTooManyRequests(errors.New("failed")); ok {
+		t.Error("expected IsTooManyRequests to return false for a generic error")
+	}
+	if _, ok := IsTooManyRequests(nil); ok {
+		t.Error("expected IsTooManyRequests to return false for nil")
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2017, 1, 30, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		Value    string
+		Expected time.Duration
+	}{
+		{"", 0},
+		{"0", 0},
+		{"120", 2 * time.Minute},
+		{" 5 ", 5 * time.Second},
+		{"-1", 0},
+		{"Mon, 30 Jan 2017 12:01:30 GMT", 90 * time.Second},
+		{"Monday, 30-Jan-17 12:00:10 GMT", 10 * time.Second},
+		{"Mon, 30 Jan 2017 11:59:00 GMT", 0}, // in the past
+		{"soon", 0},
+	}
+	for _, test := range tests {
+		if want, have := test.Expected, parseRetryAfter(test.Value, now); want != have {
+			t.Errorf("Retry-After %q: expected %v; got: %v", test.Value, want, have)
+		}
+	}
+}
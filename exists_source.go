@@ -0,0 +1,219 @@
+// Copyright 2012-present Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"golang.org/x/net/context"
+
+	"gopkg.in/olivere/elastic.v5/uritemplates"
+)
+
+// ExistsSourceService checks for the existence of the _source of a
+// document using HEAD. It returns false if either the document does not
+// exist or its _source is disabled in the mapping.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/current/docs-get.html#_source
+// for details.
+type ExistsSourceService struct {
+	client      *Client
+	pretty      bool
+	index       string
+	typ         string
+	id          string
+	routing     string
+	parent      string
+	preference  string
+	refresh     string
+	realtime    *bool
+	fsc         *FetchSourceContext
+	version     interface{}
+	versionType string
+}
+
+// NewExistsSourceService creates a new ExistsSourceService.
+func NewExistsSourceService(client *Client) *ExistsSourceService {
+	return &ExistsSourceService{
+		client: client,
+	}
+}
+
+// Index is the name of the index.
+func (s *ExistsSourceService) Index(index string) *ExistsSourceService {
+	s.index = index
+	return s
+}
+
+// Type is the type of the document. If it is empty, the typeless
+// endpoint /{index}/_source/{id} is used.
+func (s *ExistsSourceService) Type(typ string) *ExistsSourceService {
+	s.typ = typ
+	return s
+}
+
+// Id is the document ID.
+func (s *ExistsSourceService) Id(id string) *ExistsSourceService {
+	s.id = id
+	return s
+}
+
+// Parent is the ID of the parent document.
+func (s *ExistsSourceService) Parent(parent string) *ExistsSourceService {
+	s.parent = parent
+	return s
+}
+
+// Routing is the specific routing value.
+func (s *ExistsSourceService) Routing(routing string) *ExistsSourceService {
+	s.routing = routing
+	return s
+}
+
+// Preference specifies the node or shard the operation should be performed on (default: random).
+func (s *ExistsSourceService) Preference(preference string) *ExistsSourceService {
+	s.preference = preference
+	return s
+}
+
+// FetchSourceContext specifies which fields of the _source to include
+// or exclude.
+func (s *ExistsSourceService) FetchSourceContext(fetchSourceContext *FetchSourceContext) *ExistsSourceService {
+	s.fsc = fetchSourceContext
+	return s
+}
+
+// Refresh the shard containing the document before performing the operation.
+func (s *ExistsSourceService) Refresh(refresh string) *ExistsSourceService {
+	s.refresh = refresh
+	return s
+}
+
+// Realtime specifies whether to perform the operation in realtime or search mode.
+func (s *ExistsSourceService) Realtime(realtime bool) *ExistsSourceService {
+	s.realtime = &realtime
+	return s
+}
+
+// VersionType is the specific version type.
+func (s *ExistsSourceService) VersionType(versionType string) *ExistsSourceService {
+	s.versionType = versionType
+	return s
+}
+
+// Version is an explicit version number for concurrency control.
+func (s *ExistsSourceService) Version(version interface{}) *ExistsSourceService {
+	s.version = version
+	return s
+}
+
+// Pretty indicates that the JSON response be indented and human readable.
+func (s *ExistsSourceService) Pretty(pretty bool) *ExistsSourceService {
+	s.pretty = pretty
+	return s
+}
+
+// buildURL builds the URL for the operation.
+func (s *ExistsSourceService) buildURL() (string, url.Values, error) {
+	// Build URL
+	var err error
+	var path string
+	if s.typ != "" {
+		path, err = uritemplates.Expand("/{index}/{type}/{id}/_source", map[string]string{
+			"id":    s.id,
+			"index": s.index,
+			"type":  s.typ,
+		})
+	} else {
+		path, err = uritemplates.Expand("/{index}/_source/{id}", map[string]string{
+			"id":    s.id,
+			"index": s.index,
+		})
+	}
+	if err != nil {
+		return "", url.Values{}, err
+	}
+
+	// Add query string parameters
+	params := url.Values{}
+	if s.pretty {
+		params.Set("pretty", "1")
+	}
+	if s.routing != "" {
+		params.Set("routing", s.routing)
+	}
+	if s.parent != "" {
+		params.Set("parent", s.parent)
+	}
+	if s.preference != "" {
+		params.Set("preference", s.preference)
+	}
+	if s.refresh != "" {
+		params.Set("refresh", s.refresh)
+	}
+	if s.realtime != nil {
+		params.Set("realtime", fmt.Sprintf("%v", *s.realtime))
+	}
+	if s.version != nil {
+		params.Set("version", fmt.Sprintf("%v", s.version))
+	}
+	if s.versionType != "" {
+		params.Set("version_type", s.versionType)
+	}
+	if s.fsc != nil {
+		for k, values := range s.fsc.Query() {
+			params.Add(k, strings.Join(values, ","))
+		}
+	}
+	return path, params, nil
+}
+
+// Validate checks if the operation is valid.
+func (s *ExistsSourceService) Validate() error {
+	var invalid []string
+	if s.index == "" {
+		invalid = append(invalid, "Index")
+	}
+	if s.id == "" {
+		invalid = append(invalid, "Id")
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf("missing required fields: %v", invalid)
+	}
+	return nil
+}
+
+// Do executes the operation.
+func (s *ExistsSourceService) Do(ctx context.Context) (bool, error) {
+	// Check pre-conditions
+	if err := s.Validate(); err != nil {
+		return false, err
+	}
+
+	// Get URL for request
+	path, params, err := s.buildURL()
+	if err != nil {
+		return false, err
+	}
+
+	// Get HTTP response
+	res, err := s.client.PerformRequest(ctx, "HEAD", path, params, nil, 404)
+	if err != nil {
+		return false, err
+	}
+
+	// Return operation response
+	switch res.StatusCode {
+	case http.StatusOK:
+		return true, nil
+	case http.StatusNotFound:
+		return false, nil
+	default:
+		return false, fmt.Errorf("elastic: got HTTP code %d when it should have been either 200 or 404", res.StatusCode)
+	}
+}
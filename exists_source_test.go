@@ -0,0 +1,90 @@
+// Copyright 2012-present Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"golang.org/x/net/context"
+)
+
+func TestExistsSourceBuildURL(t *testing.T) {
+	client := setupTestClient(t)
+
+	tests := []struct {
+		Service        *ExistsSourceService
+		ExpectedPath   string
+		ExpectedParams url.Values
+	}{
+		{
+			client.ExistsSource().Index("twitter").Id("1"),
+			"/twitter/_source/1",
+			url.Values{},
+		},
+		{
+			client.ExistsSource().Index("twitter").Type("tweet").Id("1").Routing("kimchy"),
+			"/twitter/tweet/1/_source",
+			url.Values{"routing": []string{"kimchy"}},
+		},
+		{
+			client.ExistsSource().Index("twitter").Id("1").FetchSourceContext(NewFetchSourceContext(true).Exclude("retweets")),
+			"/twitter/_source/1",
+			url.Values{"_source_exclude": []string{"retweets"}},
+		},
+	}
+
+	for i, test := range tests {
+		path, params, err := test.Service.buildURL()
+		if err != nil {
+			t.Errorf("case #%d: %v", i+1, err)
+			continue
+		}
+		if path != test.ExpectedPath {
+			t.Errorf("case #%d: expected %q; got: %q", i+1, test.ExpectedPath, path)
+		}
+		if params.Encode() != test.ExpectedParams.Encode() {
+			t.Errorf("case #%d: expected params %v; got: %v", i+1, test.ExpectedParams, params)
+		}
+	}
+}
+
+func TestExistsSourceDo(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "HEAD" {
+			t.Errorf("expected HEAD request; got: %s", r.Method)
+		}
+		switch r.URL.Path {
+		case "/twitter/_source/1":
+			w.WriteHeader(http.StatusOK)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(SetURL(ts.URL), SetSniff(false), SetHealthcheck(false), SetMaxRetries(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	exists, err := client.ExistsSource().Index("twitter").Id("1").Do(context.TODO())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !exists {
+		t.Error("expected source to exist")
+	}
+
+	exists, err = client.ExistsSource().Index("twitter").Id("2").Do(context.TODO())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if exists {
+		t.Error("expected source to not exist")
+	}
+}
@@ -5,6 +5,8 @@
 package elastic
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
 	"net/url"
 	"strings"
@@ -23,12 +25,14 @@ type ExplainService struct {
 	id                     string
 	index                  string
 	typ                    string
+	typeless               bool
 	q                      string
 	routing                string
 	lenient                *bool
 	analyzer               string
 	df                     string
 	fields                 []string
+	storedFields           []string
 	lowercaseExpandedTerms *bool
 	xSourceInclude         []string
 	analyzeWildcard        *bool
@@ -71,6 +75,14 @@ func (s *ExplainService) Type(typ string) *ExplainService {
 	return s
 }
 
+// Typeless indicates to use the typeless endpoint /{index}/_explain/{id}
+// instead of requiring a Type. That endpoint is only available as of
+// Elasticsearch 7.0.
+func (s *ExplainService) Typeless(typeless bool) *ExplainService {
+	s.typeless = typeless
+	return s
+}
+
 // Source is the URL-encoded query definition (instead of using the request body).
 func (s *ExplainService) Source(source string) *ExplainService {
 	s.source = source
@@ -127,6 +139,12 @@ func (s *ExplainService) Fields(fields ...string) *ExplainService {
 	return s
 }
 
+// StoredFields is a list of stored fields to return in the response.
+func (s *ExplainService) StoredFields(storedFields ...string) *ExplainService {
+	s.storedFields = append(s.storedFields, storedFields...)
+	return s
+}
+
 // LowercaseExpandedTerms specifies whether query terms should be lowercased.
 func (s *ExplainService) LowercaseExpandedTerms(lowercaseExpandedTerms bool) *ExplainService {
 	s.lowercaseExpandedTerms = &lowercaseExpandedTerms
@@ -197,11 +215,20 @@ func (s *ExplainService) BodyString(body string) *ExplainService {
 // buildURL builds the URL for the operation.
 func (s *ExplainService) buildURL() (string, url.Values, error) {
 	// Build URL
-	path, err := uritemplates.Expand("/{index}/{type}/{id}/_explain", map[string]string{
-		"id":    s.id,
-		"index": s.index,
-		"type":  s.typ,
-	})
+	var err error
+	var path string
+	if !s.typeless {
+		path, err = uritemplates.Expand("/{index}/{type}/{id}/_explain", map[string]string{
+			"id":    s.id,
+			"index": s.index,
+			"type":  s.typ,
+		})
+	} else {
+		path, err = uritemplates.Expand("/{index}/_explain/{id}", map[string]string{
+			"id":    s.id,
+			"index": s.index,
+		})
+	}
 	if err != nil {
 		return "", url.Values{}, err
 	}
@@ -241,6 +268,9 @@ func (s *ExplainService) buildURL() (string, url.Values, error) {
 	if len(s.fields) > 0 {
 		params.Set("fields", strings.Join(s.fields, ","))
 	}
+	if len(s.storedFields) > 0 {
+		params.Set("stored_fields", strings.Join(s.storedFields, ","))
+	}
 	if s.lowercaseExpandedTerms != nil {
 		params.Set("lowercase_expanded_terms", fmt.Sprintf("%v", *s.lowercaseExpandedTerms))
 	}
@@ -265,7 +295,7 @@ func (s *ExplainService) Validate() error {
 	if s.index == "" {
 		invalid = append(invalid, "Index")
 	}
-	if s.typ == "" {
+	if s.typ == "" && !s.typeless {
 		invalid = append(invalid, "Type")
 	}
 	if s.id == "" {
@@ -274,10 +304,22 @@ func (s *ExplainService) Validate() error {
 	if len(invalid) > 0 {
 		return fmt.Errorf("missing required fields: %v", invalid)
 	}
+	if s.typ != "" && s.typeless {
+		return errors.New("elastic: Type and Typeless are mutually exclusive in ExplainService")
+	}
 	return nil
 }
 
-// Do executes the operation.
+// getBody returns the body of the request.
+func (s *ExplainService) getBody() interface{} {
+	if s.bodyJson != nil {
+		return s.bodyJson
+	}
+	return s.bodyString
+}
+
+// Do executes the operation. The request is sent via POST, as it usually
+// carries the query in its body; Elasticsearch accepts both GET and POST.
 func (s *ExplainService) Do(ctx context.Context) (*ExplainResponse, error) {
 	// Check pre-conditions
 	if err := s.Validate(); err != nil {
@@ -290,16 +332,8 @@ func (s *ExplainService) Do(ctx context.Context) (*ExplainResponse, error) {
 		return nil, err
 	}
 
-	// Setup HTTP request body
-	var body interface{}
-	if s.bodyJson != nil {
-		body = s.bodyJson
-	} else {
-		body = s.bodyString
-	}
-
 	// Get HTTP response
-	res, err := s.client.PerformRequest(ctx, "GET", path, params, body)
+	res, err := s.client.PerformRequest(ctx, "POST", path, params, s.getBody())
 	if err != nil {
 		return nil, err
 	}
@@ -313,10 +347,74 @@ func (s *ExplainService) Do(ctx context.Context) (*ExplainResponse, error) {
 }
 
 // ExplainResponse is the response of ExplainService.Do.
+// ExplanationDetails holds the same explanation as Explanation,
+// decoded into a typed and nested structure.
 type ExplainResponse struct {
-	Index       string                 `json:"_index"`
-	Type        string                 `json:"_type"`
-	Id          string                 `json:"_id"`
-	Matched     bool                   `json:"matched"`
-	Explanation map[string]interface{} `json:"explanation"`
+	Index              string                 `json:"_index"`
+	Type               string                 `json:"_type"`
+	Id                 string                 `json:"_id"`
+	Matched            bool                   `json:"matched"`
+	Explanation        map[string]interface{} `json:"explanation"`
+	ExplanationDetails *SearchExplanation     `json:"-"`
+	Get                *GetResult             `json:"get,omitempty"` // returned with _source or stored_fields
+}
+
+// UnmarshalJSON decodes JSON data and initializes an ExplainResponse
+// structure, including its ExplanationDetails.
+func (r *ExplainResponse) UnmarshalJSON(data []byte) error {
+	type explainResponse ExplainResponse // avoid recursion
+	if err := json.Unmarshal(data, (*explainResponse)(r)); err != nil {
+		return err
+	}
+	var details struct {
+		Explanation *SearchExplanation `json:"explanation"`
+	}
+	if err := json.Unmarshal(data, &details); err != nil {
+		return err
+	}
+	r.ExplanationDetails = details.Explanation
+	return nil
+}
+
+// -- Helpers --
+
+// CompareScores computes the scores of two queries for a single document
+// by means of the Explain API. It is useful e.g. in relevance tests to
+// assert that boosting a query increases the score of a document.
+//
+// If typ is empty, the typeless endpoint is used, which requires
+// Elasticsearch 7.0 or later. A query that does not match the document
+// has a score of 0.
+func (c *Client) CompareScores(ctx context.Context, index, typ, id string, qA, qB Query) (scoreA, scoreB float64, err error) {
+	scoreA, err = c.explainScore(ctx, index, typ, id, qA)
+	if err != nil {
+		return 0, 0, err
+	}
+	scoreB, err = c.explainScore(ctx, index, typ, id, qB)
+	if err != nil {
+		return 0, 0, err
+	}
+	return scoreA, scoreB, nil
+}
+
+// explainScore returns the score of query q for the given document.
+func (c *Client) explainScore(ctx context.Context, index, typ, id string, q Query) (float64, error) {
+	if q == nil {
+		return 0, errors.New("elastic: query is required in CompareScores")
+	}
+	src, err := q.Source()
+	if err != nil {
+		return 0, err
+	}
+	res, err := c.Explain(index, typ, id).
+		Typeless(typ == "").
+		BodyJson(map[string]interface{}{"query": src}).
+		Do(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if !res.Matched || res.ExplanationDetails == nil {
+		return 0, nil
+	}
+	return res.ExplanationDetails.Value, nil
 }
@@ -5,6 +5,12 @@
 package elastic
 
 import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"testing"
 
 	"golang.org/x/net/context"
@@ -43,3 +49,227 @@ func TestExplain(t *testing.T) {
 		t.Errorf("expected matched to be %v; got: %v", true, expl.Matched)
 	}
 }
+
+func TestExplainBuildURL(t *testing.T) {
+	client := setupTestClient(t)
+
+	tests := []struct {
+		Service        *ExplainService
+		ExpectedPath   string
+		ExpectedParams url.Values
+	}{
+		{
+			client.Explain("twitter", "tweet", "1"),
+			"/twitter/tweet/1/_explain",
+			url.Values{},
+		},
+		{
+			client.Explain("twitter", "", "1").Typeless(true).Routing("kimchy").Preference("_local"),
+			"/twitter/_explain/1",
+			url.Values{"routing": []string{"kimchy"}, "preference": []string{"_local"}},
+		},
+		{
+			client.Explain("twitter", "", "1").Typeless(true).XSource("user", "message").StoredFields("retweets"),
+			"/twitter/_explain/1",
+			url.Values{"_source": []string{"user,message"}, "stored_fields": []string{"retweets"}},
+		},
+	}
+
+	for i, test := range tests {
+		path, params, err := test.Service.buildURL()
+		if err != nil {
+			t.Errorf("case #%d: %v", i+1, err)
+			continue
+		}
+		if path != test.ExpectedPath {
+			t.Errorf("case #%d: expected %q; got: %q", i+1, test.ExpectedPath, path)
+		}
+		if params.Encode() != test.ExpectedParams.Encode() {
+			t.Errorf("case #%d: expected params %v; got: %v", i+1, test.ExpectedParams, params)
+		}
+	}
+}
+
+func TestExplainValidate(t *testing.T) {
+	client := setupTestClient(t)
+
+	if err := client.Explain("twitter", "tweet", "1").Validate(); err != nil {
+		t.Errorf("expected no error; got: %v", err)
+	}
+	if err := client.Explain("twitter", "", "1").Validate(); err == nil {
+		t.Error("expected error without type")
+	}
+	if err := client.Explain("twitter", "", "1").Typeless(true).Validate(); err != nil {
+		t.Errorf("expected no error without type in typeless mode; got: %v", err)
+	}
+	if err := client.Explain("twitter", "tweet", "1").Typeless(true).Validate(); err == nil {
+		t.Error("expected error with type in typeless mode")
+	}
+	if err := client.Explain("", "tweet", "1").Validate(); err == nil {
+		t.Error("expected error without index")
+	}
+	if err := client.Explain("twitter", "tweet", "").Validate(); err == nil {
+		t.Error("expected error without id")
+	}
+}
+
+func TestExplainBody(t *testing.T) {
+	client := setupTestClient(t)
+
+	svc := client.Explain("twitter", "tweet", "1").Query(NewTermQuery("user", "olivere"))
+	data, err := json.Marshal(svc.getBody())
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"query":{"term":{"user":"olivere"}}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
+
+func TestExplainResponseWithNestedExplanation(t *testing.T) {
+	body := `{
+		"_index": "twitter",
+		"_type": "tweet",
+		"_id": "1",
+		"matched": true,
+		"explanation": {
+			"value": 1.55,
+			"description": "sum of:",
+			"details": [{
+				"value": 1.55,
+				"description": "weight(message:elasticsearch in 0) [PerFieldSimilarity], result of:",
+				"details": [{
+					"value": 1.55,
+					"description": "score(doc=0,freq=1.0 = termFreq=1.0), product of:",
+					"details": [
+						{ "value": 1.38, "description": "idf, computed as log(1 + (docCount - docFreq + 0.5) / (docFreq + 0.5)) from:", "details": [] },
+						{ "value": 1.12, "description": "tfNorm, computed as (freq * (k1 + 1)) / (freq + k1 * (1 - b + b * fieldLength / avgFieldLength)) from:", "details": [] }
+					]
+				}]
+			}]
+		}
+	}`
+	var res ExplainResponse
+	if err := json.Unmarshal([]byte(body), &res); err != nil {
+		t.Fatal(err)
+	}
+	if !res.Matched {
+		t.Errorf("expected matched to be %v; got: %v", true, res.Matched)
+	}
+	if want, have := "sum of:", res.Explanation["description"]; want != have {
+		t.Errorf("expected description = %q; got: %v", want, have)
+	}
+	if res.ExplanationDetails == nil {
+		t.Fatal("expected explanation details; got: nil")
+	}
+	if want, have := 1.55, res.ExplanationDetails.Value; want != have {
+		t.Errorf("expected value = %v; got: %v", want, have)
+	}
+	if want, have := "sum of:", res.ExplanationDetails.Description; want != have {
+		t.Errorf("expected description = %q; got: %q", want, have)
+	}
+	if want, have := 1, len(res.ExplanationDetails.Details); want != have {
+		t.Fatalf("expected %d details; got: %d", want, have)
+	}
+	score := res.ExplanationDetails.Details[0].Details[0]
+	if want, have := 2, len(score.Details); want != have {
+		t.Fatalf("expected %d details; got: %d", want, have)
+	}
+	if want, have := 1.38, score.Details[0].Value; want != have {
+		t.Errorf("expected idf value = %v; got: %v", want, have)
+	}
+}
+
+func TestCompareScoresWithBoost(t *testing.T) {
+	var paths, bodies []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		paths = append(paths, r.URL.Path)
+		bodies = append(bodies, string(body))
+		// Score the document by the boost of the term query, if any
+		var req struct {
+			Query struct {
+				Term struct {
+					User struct {
+						Boost *float64 `json:"boost"`
+					} `json:"user"`
+				} `json:"term"`
+			} `json:"query"`
+		}
+		score := 0.75
+		if err := json.Unmarshal(body, &req); err == nil && req.Query.Term.User.Boost != nil {
+			score *= *req.Query.Term.User.Boost
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"_index":"twitter","_type":"tweet","_id":"1","matched":true,"explanation":{"value":%v,"description":"weight(user:olivere)","details":[]}}`, score)
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(SetURL(ts.URL), SetSniff(false), SetHealthcheck(false), SetMaxRetries(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	qA := NewTermQuery("user", "olivere")
+	qB := NewTermQuery("user", "olivere").Boost(2)
+	scoreA, scoreB, err := client.CompareScores(context.TODO(), "twitter", "tweet", "1", qA, qB)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := 0.75; scoreA != want {
+		t.Errorf("expected score of unboosted query = %v; got: %v", want, scoreA)
+	}
+	if scoreB <= scoreA {
+		t.Errorf("expected boosted query to score higher; got: %v <= %v", scoreB, scoreA)
+	}
+
+	expectedPaths := []string{"/twitter/tweet/1/_explain", "/twitter/tweet/1/_explain"}
+	expectedBodies := []string{
+		`{"query":{"term":{"user":"olivere"}}}`,
+		`{"query":{"term":{"user":{"boost":2,"value":"olivere"}}}}`,
+	}
+	if len(bodies) != len(expectedBodies) {
+		t.Fatalf("expected %d requests; got: %d", len(expectedBodies), len(bodies))
+	}
+	for i := range expectedBodies {
+		if paths[i] != expectedPaths[i] {
+			t.Errorf("request #%d: expected path %q; got: %q", i+1, expectedPaths[i], paths[i])
+		}
+		if bodies[i] != expectedBodies[i] {
+			t.Errorf("request #%d: expected body\n%s\n,got:\n%s", i+1, expectedBodies[i], bodies[i])
+		}
+	}
+}
+
+func TestCompareScoresTypeless(t *testing.T) {
+	var path string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"_index":"twitter","_id":"1","matched":false}`)
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(SetURL(ts.URL), SetSniff(false), SetHealthcheck(false), SetMaxRetries(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	q := NewTermQuery("user", "olivere")
+	scoreA, scoreB, err := client.CompareScores(context.TODO(), "twitter", "", "1", q, q)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "/twitter/_explain/1"; path != want {
+		t.Errorf("expected path %q; got: %q", want, path)
+	}
+	if scoreA != 0 || scoreB != 0 {
+		t.Errorf("expected scores of 0 for unmatched document; got: %v and %v", scoreA, scoreB)
+	}
+}
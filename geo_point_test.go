@@ -6,6 +6,7 @@ package elastic
 
 import (
 	"encoding/json"
+	"math"
 	"testing"
 )
 
@@ -22,3 +23,68 @@ func TestGeoPointSource(t *testing.T) {
 		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
 	}
 }
+
+func TestGeoPointFromLatLon(t *testing.T) {
+	pt := GeoPointFromLatLon(40.10210, -70.12091)
+	data, err := json.Marshal(pt.Source())
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"lat":40.1021,"lon":-70.12091}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
+
+func TestGeoPointFromString(t *testing.T) {
+	pt, err := GeoPointFromString("40.10210,-70.12091")
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(pt.Source())
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"lat":40.1021,"lon":-70.12091}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+
+	for _, invalid := range []string{"", "40.1", "abc,-70", "40,def"} {
+		if _, err := GeoPointFromString(invalid); err == nil {
+			t.Errorf("expected error for %q", invalid)
+		}
+	}
+}
+
+func TestGeoPointFromGeohash(t *testing.T) {
+	tests := []struct {
+		Geohash string
+		Lat     float64
+		Lon     float64
+	}{
+		{"u4pruydqqvj", 57.64911, 10.40744},
+		{"drm3btev3e86", 41.12, -71.34},
+		{"DRM3BTEV3E86", 41.12, -71.34},
+	}
+	for i, test := range tests {
+		pt, err := GeoPointFromGeohash(test.Geohash)
+		if err != nil {
+			t.Fatalf("case #%d: %v", i+1, err)
+		}
+		if math.Abs(pt.Lat-test.Lat) > 1e-4 {
+			t.Errorf("case #%d: expected lat %v; got: %v", i+1, test.Lat, pt.Lat)
+		}
+		if math.Abs(pt.Lon-test.Lon) > 1e-4 {
+			t.Errorf("case #%d: expected lon %v; got: %v", i+1, test.Lon, pt.Lon)
+		}
+	}
+
+	for _, invalid := range []string{"", "drm3a", "u4pr!"} {
+		if _, err := GeoPointFromGeohash(invalid); err == nil {
+			t.Errorf("expected error for %q", invalid)
+		}
+	}
+}
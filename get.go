@@ -255,3 +255,13 @@ type GetResult struct {
 	// TODO double-check that MultiGet now returns details error information
 	Error *ErrorDetails `json:"error,omitempty"` // only used in MultiGet
 }
+
+// UnmarshalSource decodes the source of the document into v, like
+// json.Unmarshal. It returns ErrNoSource if the document has no source,
+// e.g. because it was not found or source filtering excluded it.
+func (r *GetResult) UnmarshalSource(v interface{}) error {
+	if r == nil || r.Source == nil {
+		return ErrNoSource
+	}
+	return json.Unmarshal(*r.Source, v)
+}
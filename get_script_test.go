@@ -0,0 +1,61 @@
+// Copyright 2012-present Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestGetScriptURL(t *testing.T) {
+	client := setupTestClient(t)
+
+	path, _, err := client.GetScript().Id("my-script").buildURL()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, have := "/_scripts/my-script", path; want != have {
+		t.Errorf("expected %q; got: %q", want, have)
+	}
+}
+
+func TestGetScriptResponse(t *testing.T) {
+	body := `{
+		"_id": "my-script",
+		"found": true,
+		"script": {
+			"lang": "painless",
+			"source": "Math.log(_score * 2) + params.my_modifier"
+		}
+	}`
+	var res GetScriptResponse
+	if err := json.Unmarshal([]byte(body), &res); err != nil {
+		t.Fatal(err)
+	}
+	if want, have := "my-script", res.Id; want != have {
+		t.Errorf("expected _id %q; got: %q", want, have)
+	}
+	if !res.Found {
+		t.Error("expected found = true")
+	}
+	if res.Script == nil {
+		t.Fatal("expected script")
+	}
+	if want, have := "painless", res.Script.Lang; want != have {
+		t.Errorf("expected lang %q; got: %q", want, have)
+	}
+	if want, have := "Math.log(_score * 2) + params.my_modifier", res.Script.Source; want != have {
+		t.Errorf("expected source %q; got: %q", want, have)
+	}
+
+	// Not found
+	res = GetScriptResponse{}
+	if err := json.Unmarshal([]byte(`{"_id":"no-such-script","found":false}`), &res); err != nil {
+		t.Fatal(err)
+	}
+	if res.Found || res.Script != nil {
+		t.Errorf("expected script to be not found; got: %+v", res)
+	}
+}
@@ -0,0 +1,215 @@
+// Copyright 2012-present Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"golang.org/x/net/context"
+
+	"gopkg.in/olivere/elastic.v5/uritemplates"
+)
+
+// GetSourceService returns the _source of a document, without any of
+// the metadata returned by GetService.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/current/docs-get.html#_source
+// for details.
+type GetSourceService struct {
+	client      *Client
+	pretty      bool
+	index       string
+	typ         string
+	id          string
+	routing     string
+	parent      string
+	preference  string
+	refresh     string
+	realtime    *bool
+	fsc         *FetchSourceContext
+	version     interface{}
+	versionType string
+}
+
+// NewGetSourceService creates a new GetSourceService.
+func NewGetSourceService(client *Client) *GetSourceService {
+	return &GetSourceService{
+		client: client,
+	}
+}
+
+// Index is the name of the index.
+func (s *GetSourceService) Index(index string) *GetSourceService {
+	s.index = index
+	return s
+}
+
+// Type is the type of the document. If it is empty, the typeless
+// endpoint /{index}/_source/{id} is used.
+func (s *GetSourceService) Type(typ string) *GetSourceService {
+	s.typ = typ
+	return s
+}
+
+// Id is the document ID.
+func (s *GetSourceService) Id(id string) *GetSourceService {
+	s.id = id
+	return s
+}
+
+// Parent is the ID of the parent document.
+func (s *GetSourceService) Parent(parent string) *GetSourceService {
+	s.parent = parent
+	return s
+}
+
+// Routing is the specific routing value.
+func (s *GetSourceService) Routing(routing string) *GetSourceService {
+	s.routing = routing
+	return s
+}
+
+// Preference specifies the node or shard the operation should be performed on (default: random).
+func (s *GetSourceService) Preference(preference string) *GetSourceService {
+	s.preference = preference
+	return s
+}
+
+// FetchSourceContext specifies which fields of the _source to include
+// or exclude.
+func (s *GetSourceService) FetchSourceContext(fetchSourceContext *FetchSourceContext) *GetSourceService {
+	s.fsc = fetchSourceContext
+	return s
+}
+
+// Refresh the shard containing the document before performing the operation.
+func (s *GetSourceService) Refresh(refresh string) *GetSourceService {
+	s.refresh = refresh
+	return s
+}
+
+// Realtime specifies whether to perform the operation in realtime or search mode.
+func (s *GetSourceService) Realtime(realtime bool) *GetSourceService {
+	s.realtime = &realtime
+	return s
+}
+
+// VersionType is the specific version type.
+func (s *GetSourceService) VersionType(versionType string) *GetSourceService {
+	s.versionType = versionType
+	return s
+}
+
+// Version is an explicit version number for concurrency control.
+func (s *GetSourceService) Version(version interface{}) *GetSourceService {
+	s.version = version
+	return s
+}
+
+// Pretty indicates that the JSON response be indented and human readable.
+func (s *GetSourceService) Pretty(pretty bool) *GetSourceService {
+	s.pretty = pretty
+	return s
+}
+
+// buildURL builds the URL for the operation.
+func (s *GetSourceService) buildURL() (string, url.Values, error) {
+	// Build URL
+	var err error
+	var path string
+	if s.typ != "" {
+		path, err = uritemplates.Expand("/{index}/{type}/{id}/_source", map[string]string{
+			"id":    s.id,
+			"index": s.index,
+			"type":  s.typ,
+		})
+	} else {
+		path, err = uritemplates.Expand("/{index}/_source/{id}", map[string]string{
+			"id":    s.id,
+			"index": s.index,
+		})
+	}
+	if err != nil {
+		return "", url.Values{}, err
+	}
+
+	// Add query string parameters
+	params := url.Values{}
+	if s.pretty {
+		params.Set("pretty", "1")
+	}
+	if s.routing != "" {
+		params.Set("routing", s.routing)
+	}
+	if s.parent != "" {
+		params.Set("parent", s.parent)
+	}
+	if s.preference != "" {
+		params.Set("preference", s.preference)
+	}
+	if s.refresh != "" {
+		params.Set("refresh", s.refresh)
+	}
+	if s.realtime != nil {
+		params.Set("realtime", fmt.Sprintf("%v", *s.realtime))
+	}
+	if s.version != nil {
+		params.Set("version", fmt.Sprintf("%v", s.version))
+	}
+	if s.versionType != "" {
+		params.Set("version_type", s.versionType)
+	}
+	if s.fsc != nil {
+		for k, values := range s.fsc.Query() {
+			params.Add(k, strings.Join(values, ","))
+		}
+	}
+	return path, params, nil
+}
+
+// Validate checks if the operation is valid.
+func (s *GetSourceService) Validate() error {
+	var invalid []string
+	if s.index == "" {
+		invalid = append(invalid, "Index")
+	}
+	if s.id == "" {
+		invalid = append(invalid, "Id")
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf("missing required fields: %v", invalid)
+	}
+	return nil
+}
+
+// Do executes the operation. It returns the raw _source of the document.
+func (s *GetSourceService) Do(ctx context.Context) (json.RawMessage, error) {
+	// Check pre-conditions
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+
+	// Get URL for request
+	path, params, err := s.buildURL()
+	if err != nil {
+		return nil, err
+	}
+
+	// Get HTTP response
+	res, err := s.client.PerformRequest(ctx, "GET", path, params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return operation response
+	var ret json.RawMessage
+	if err := s.client.decoder.Decode(res.Body, &ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
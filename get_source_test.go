@@ -0,0 +1,99 @@
+// Copyright 2012-present Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"golang.org/x/net/context"
+)
+
+func TestGetSourceBuildURL(t *testing.T) {
+	client := setupTestClient(t)
+
+	tests := []struct {
+		Service        *GetSourceService
+		ExpectedPath   string
+		ExpectedParams url.Values
+	}{
+		{
+			client.GetSource().Index("twitter").Id("1"),
+			"/twitter/_source/1",
+			url.Values{},
+		},
+		{
+			client.GetSource().Index("twitter").Type("tweet").Id("1"),
+			"/twitter/tweet/1/_source",
+			url.Values{},
+		},
+		{
+			client.GetSource().Index("twitter").Id("1").Routing("kimchy").Preference("_local").Version(3),
+			"/twitter/_source/1",
+			url.Values{"routing": []string{"kimchy"}, "preference": []string{"_local"}, "version": []string{"3"}},
+		},
+		{
+			client.GetSource().Index("twitter").Id("1").FetchSourceContext(NewFetchSourceContext(true).Include("user", "message").Exclude("retweets")),
+			"/twitter/_source/1",
+			url.Values{"_source_include": []string{"user,message"}, "_source_exclude": []string{"retweets"}},
+		},
+	}
+
+	for i, test := range tests {
+		path, params, err := test.Service.buildURL()
+		if err != nil {
+			t.Errorf("case #%d: %v", i+1, err)
+			continue
+		}
+		if path != test.ExpectedPath {
+			t.Errorf("case #%d: expected %q; got: %q", i+1, test.ExpectedPath, path)
+		}
+		if params.Encode() != test.ExpectedParams.Encode() {
+			t.Errorf("case #%d: expected params %v; got: %v", i+1, test.ExpectedParams, params)
+		}
+	}
+}
+
+func TestGetSourceValidate(t *testing.T) {
+	client := setupTestClient(t)
+
+	if err := client.GetSource().Id("1").Validate(); err == nil {
+		t.Fatal("expected error when index is missing")
+	}
+	if err := client.GetSource().Index("twitter").Validate(); err == nil {
+		t.Fatal("expected error when id is missing")
+	}
+	if err := client.GetSource().Index("twitter").Id("1").Validate(); err != nil {
+		t.Fatalf("expected no error; got: %v", err)
+	}
+}
+
+func TestGetSourceDo(t *testing.T) {
+	var path string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"user":"olivere","message":"Welcome to Golang and Elasticsearch."}`)
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(SetURL(ts.URL), SetSniff(false), SetHealthcheck(false), SetMaxRetries(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	source, err := client.GetSource().Index("twitter").Id("1").Do(context.TODO())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, have := "/twitter/_source/1", path; want != have {
+		t.Errorf("expected path %q; got: %q", want, have)
+	}
+	if want, have := `{"user":"olivere","message":"Welcome to Golang and Elasticsearch."}`, string(source); want != have {
+		t.Errorf("expected source %s; got: %s", want, have)
+	}
+}
@@ -165,3 +165,25 @@ func TestGetValidate(t *testing.T) {
 		t.Fatal("expected Get to fail")
 	}
 }
+
+func TestGetResultUnmarshalSource(t *testing.T) {
+	source := json.RawMessage(`{"user":"olivere","message":"Welcome to Golang and Elasticsearch.","retweets":108}`)
+	res := &GetResult{Id: "1", Found: true, Source: &source}
+
+	var tw tweet
+	if err := res.UnmarshalSource(&tw); err != nil {
+		t.Fatal(err)
+	}
+	if want, have := "olivere", tw.User; want != have {
+		t.Errorf("expected user = %q; got: %q", want, have)
+	}
+	if want, have := 108, tw.Retweets; want != have {
+		t.Errorf("expected retweets = %d; got: %d", want, have)
+	}
+
+	// Document not found
+	res = &GetResult{Id: "2", Found: false}
+	if err := res.UnmarshalSource(&tw); err != ErrNoSource {
+		t.Errorf("expected ErrNoSource; got: %v", err)
+	}
+}
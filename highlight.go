@@ -20,6 +20,9 @@ type Highlight struct {
 	requireFieldMatch     *bool
 	boundaryMaxScan       *int
 	boundaryChars         []rune
+	boundaryScanner       *string
+	boundaryScannerLocale *string
+	maxAnalyzedOffset     *int
 	highlighterType       *string
 	fragmenter            *string
 	highlightQuery        Query
@@ -107,6 +110,27 @@ func (hl *Highlight) BoundaryChars(boundaryChars ...rune) *Highlight {
 	return hl
 }
 
+// BoundaryScanner specifies how to break the highlighted fragments
+// with the unified or fvh highlighter: "chars", "sentence", or "word".
+func (hl *Highlight) BoundaryScanner(boundaryScanner string) *Highlight {
+	hl.boundaryScanner = &boundaryScanner
+	return hl
+}
+
+// BoundaryScannerLocale specifies the locale used to search for sentence
+// and word boundaries, e.g. "en-US".
+func (hl *Highlight) BoundaryScannerLocale(boundaryScannerLocale string) *Highlight {
+	hl.boundaryScannerLocale = &boundaryScannerLocale
+	return hl
+}
+
+// MaxAnalyzedOffset sets the maximum number of characters analyzed for
+// a highlight request. Characters beyond the limit are not highlighted.
+func (hl *Highlight) MaxAnalyzedOffset(maxAnalyzedOffset int) *Highlight {
+	hl.maxAnalyzedOffset = &maxAnalyzedOffset
+	return hl
+}
+
 func (hl *Highlight) HighlighterType(highlighterType string) *Highlight {
 	hl.highlighterType = &highlighterType
 	return hl
@@ -182,6 +206,15 @@ func (hl *Highlight) Source() (interface{}, error) {
 	if hl.boundaryChars != nil && len(hl.boundaryChars) > 0 {
 		source["boundary_chars"] = hl.boundaryChars
 	}
+	if hl.boundaryScanner != nil {
+		source["boundary_scanner"] = *hl.boundaryScanner
+	}
+	if hl.boundaryScannerLocale != nil {
+		source["boundary_scanner_locale"] = *hl.boundaryScannerLocale
+	}
+	if hl.maxAnalyzedOffset != nil {
+		source["max_analyzed_offset"] = *hl.maxAnalyzedOffset
+	}
 	if hl.highlighterType != nil {
 		source["type"] = *hl.highlighterType
 	}
@@ -253,6 +286,9 @@ type HighlighterField struct {
 	requireFieldMatch *bool
 	boundaryMaxScan   int
 	boundaryChars     []rune
+	boundaryScanner   *string
+	boundaryLocale    *string
+	maxAnalyzedOffset *int
 	highlighterType   *string
 	fragmenter        *string
 	highlightQuery    Query
@@ -349,6 +385,27 @@ func (f *HighlighterField) BoundaryChars(boundaryChars ...rune) *HighlighterFiel
 	return f
 }
 
+// BoundaryScanner specifies how to break the highlighted fragments
+// with the unified or fvh highlighter: "chars", "sentence", or "word".
+func (f *HighlighterField) BoundaryScanner(boundaryScanner string) *HighlighterField {
+	f.boundaryScanner = &boundaryScanner
+	return f
+}
+
+// BoundaryScannerLocale specifies the locale used to search for sentence
+// and word boundaries, e.g. "en-US".
+func (f *HighlighterField) BoundaryScannerLocale(boundaryScannerLocale string) *HighlighterField {
+	f.boundaryLocale = &boundaryScannerLocale
+	return f
+}
+
+// MaxAnalyzedOffset sets the maximum number of characters analyzed for
+// this field. Characters beyond the limit are not highlighted.
+func (f *HighlighterField) MaxAnalyzedOffset(maxAnalyzedOffset int) *HighlighterField {
+	f.maxAnalyzedOffset = &maxAnalyzedOffset
+	return f
+}
+
 func (f *HighlighterField) HighlighterType(highlighterType string) *HighlighterField {
 	f.highlighterType = &highlighterType
 	return f
@@ -422,6 +479,15 @@ func (f *HighlighterField) Source() (interface{}, error) {
 	if f.boundaryChars != nil && len(f.boundaryChars) > 0 {
 		source["boundary_chars"] = f.boundaryChars
 	}
+	if f.boundaryScanner != nil {
+		source["boundary_scanner"] = *f.boundaryScanner
+	}
+	if f.boundaryLocale != nil {
+		source["boundary_scanner_locale"] = *f.boundaryLocale
+	}
+	if f.maxAnalyzedOffset != nil {
+		source["max_analyzed_offset"] = *f.maxAnalyzedOffset
+	}
 	if f.highlighterType != nil {
 		source["type"] = *f.highlighterType
 	}
@@ -45,6 +45,68 @@ func TestHighlighterFieldWithOptions(t *testing.T) {
 	}
 }
 
+func TestHighlighterFieldWithUnifiedOptions(t *testing.T) {
+	field := NewHighlighterField("comment").
+		HighlighterType("unified").
+		MatchedFields("comment", "comment.plain").
+		FragmentOffset(10).
+		MaxAnalyzedOffset(1000000).
+		BoundaryScanner("sentence").
+		BoundaryScannerLocale("en-US")
+	src, err := field.Source()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(src)
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"boundary_scanner":"sentence","boundary_scanner_locale":"en-US","fragment_offset":10,"matched_fields":["comment","comment.plain"],"max_analyzed_offset":1000000,"type":"unified"}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
+
+func TestHighlightWithStyledTagsSchemaAndEncoder(t *testing.T) {
+	builder := NewHighlight().
+		TagsSchema("styled").
+		Encoder("html").
+		MaxAnalyzedOffset(500).
+		Fields(NewHighlighterField("comment").MatchedFields("comment", "comment.plain"))
+	src, err := builder.Source()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(src)
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"encoder":"html","fields":{"comment":{"matched_fields":["comment","comment.plain"]}},"max_analyzed_offset":500,"tags_schema":"styled"}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
+
+func TestHighlightWithFieldNoMatchSize(t *testing.T) {
+	field := NewHighlighterField("content").NoMatchSize(150)
+	builder := NewHighlight().Fields(field)
+	src, err := builder.Source()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(src)
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"fields":{"content":{"no_match_size":150}}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
+
 func TestHighlightWithStringField(t *testing.T) {
 	builder := NewHighlight().Field("grade")
 	src, err := builder.Source()
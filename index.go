@@ -80,7 +80,11 @@ func (s *IndexService) Pipeline(pipeline string) *IndexService {
 	return s
 }
 
-// Refresh the index after performing the operation.
+// Refresh controls when changes made by this request are made visible
+// to search. The allowed values are: "true" (refresh the relevant
+// primary and replica shards immediately), "wait_for" (wait for the
+// changes to be made visible by a refresh before applying), or "false"
+// (no refresh related actions).
 func (s *IndexService) Refresh(refresh string) *IndexService {
 	s.refresh = refresh
 	return s
@@ -240,9 +244,23 @@ func (s *IndexService) Validate() error {
 	if len(invalid) > 0 {
 		return fmt.Errorf("missing required fields: %v", invalid)
 	}
+	if err := validateRefresh(s.refresh); err != nil {
+		return err
+	}
 	return nil
 }
 
+// validateRefresh checks the value passed to the refresh parameter of
+// the write APIs. An empty value means the parameter is not sent.
+// Otherwise the allowed values are "true", "false", and "wait_for".
+func validateRefresh(refresh string) error {
+	switch refresh {
+	case "", "true", "false", "wait_for":
+		return nil
+	}
+	return fmt.Errorf("elastic: invalid refresh value %q; must be one of true, false, or wait_for", refresh)
+}
+
 // Do executes the operation.
 func (s *IndexService) Do(ctx context.Context) (*IndexResponse, error) {
 	// Check pre-conditions
@@ -279,3 +279,26 @@ func TestIndexCreateExistsOpenCloseDelete(t *testing.T) {
 		t.Errorf("expected ack for deleting index; got %v", deleteIndex.Acknowledged)
 	}
 }
+
+func TestIndexRefresh(t *testing.T) {
+	client := setupTestClient(t)
+
+	tweet := tweet{User: "olivere", Message: "Welcome to Golang and Elasticsearch."}
+
+	svc := NewIndexService(client).Index(testIndexName).Type("tweet").Id("1").BodyJson(&tweet).Refresh("wait_for")
+	if err := svc.Validate(); err != nil {
+		t.Fatalf("expected Index to accept refresh=wait_for; got: %v", err)
+	}
+	_, _, params, err := svc.buildURL()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, have := "wait_for", params.Get("refresh"); want != have {
+		t.Errorf("expected refresh=%q; got: %q", want, have)
+	}
+
+	svc = NewIndexService(client).Index(testIndexName).Type("tweet").Id("1").BodyJson(&tweet).Refresh("yes")
+	if err := svc.Validate(); err == nil {
+		t.Fatalf("expected Index to fail with invalid refresh value")
+	}
+}
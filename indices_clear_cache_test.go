@@ -0,0 +1,105 @@
+// Copyright 2012-present Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"golang.org/x/net/context"
+)
+
+func TestIndicesClearCacheBuildURL(t *testing.T) {
+	client := setupTestClient(t)
+
+	tests := []struct {
+		Service       *IndicesClearCacheService
+		ExpectedPath  string
+		ExpectedQuery string
+	}{
+		{
+			client.ClearCache(),
+			"/_cache/clear",
+			"",
+		},
+		{
+			client.ClearCache("index1", "index2"),
+			"/index1%2Cindex2/_cache/clear",
+			"",
+		},
+		{
+			client.ClearCache("index1").Query(true),
+			"/index1/_cache/clear",
+			"query=true",
+		},
+		{
+			client.ClearCache("index1").Request(true),
+			"/index1/_cache/clear",
+			"request=true",
+		},
+		{
+			client.ClearCache("index1").Fielddata(true).Fields("user", "message"),
+			"/index1/_cache/clear",
+			"fielddata=true&fields=user%2Cmessage",
+		},
+		{
+			client.ClearCache("index1").Fielddata(true).Fields("user").Fields("message", "retweets"),
+			"/index1/_cache/clear",
+			"fielddata=true&fields=user%2Cmessage%2Cretweets",
+		},
+		{
+			client.ClearCache().Query(false).Request(true).IgnoreUnavailable(true),
+			"/_cache/clear",
+			"ignore_unavailable=true&query=false&request=true",
+		},
+	}
+
+	for i, test := range tests {
+		path, params, err := test.Service.buildURL()
+		if err != nil {
+			t.Fatalf("case #%d: %v", i+1, err)
+		}
+		if want, have := test.ExpectedPath, path; want != have {
+			t.Errorf("case #%d: expected path %q; got: %q", i+1, want, have)
+		}
+		if want, have := test.ExpectedQuery, params.Encode(); want != have {
+			t.Errorf("case #%d: expected query %q; got: %q", i+1, want, have)
+		}
+	}
+}
+
+func TestIndicesClearCacheResponse(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if want, have := "POST", r.Method; want != have {
+			t.Errorf("expected method %q; got: %q", want, have)
+		}
+		if want, have := "/twitter/_cache/clear", r.URL.Path; want != have {
+			t.Errorf("expected path %q; got: %q", want, have)
+		}
+		if want, have := "query=true&request=true", r.URL.RawQuery; want != have {
+			t.Errorf("expected query %q; got: %q", want, have)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"_shards":{"total":2,"successful":2,"failed":0}}`)
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(SetURL(ts.URL), SetSniff(false), SetHealthcheck(false), SetMaxRetries(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := client.ClearCache("twitter").Query(true).Request(true).Do(context.TODO())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, have := 2, res.Shards.Total; want != have {
+		t.Errorf("expected %d total shards; got: %d", want, have)
+	}
+	if want, have := 2, res.Shards.Successful; want != have {
+		t.Errorf("expected %d successful shards; got: %d", want, have)
+	}
+}
@@ -7,6 +7,7 @@ package elastic
 import (
 	"fmt"
 	"net/url"
+	"strings"
 
 	"golang.org/x/net/context"
 
@@ -18,14 +19,15 @@ import (
 // See https://www.elastic.co/guide/en/elasticsearch/reference/current/indices-open-close.html
 // for details.
 type IndicesCloseService struct {
-	client            *Client
-	pretty            bool
-	index             string
-	timeout           string
-	masterTimeout     string
-	ignoreUnavailable *bool
-	allowNoIndices    *bool
-	expandWildcards   string
+	client              *Client
+	pretty              bool
+	index               []string
+	timeout             string
+	masterTimeout       string
+	ignoreUnavailable   *bool
+	allowNoIndices      *bool
+	expandWildcards     string
+	waitForActiveShards string
 }
 
 // NewIndicesCloseService creates and initializes a new IndicesCloseService.
@@ -33,9 +35,9 @@ func NewIndicesCloseService(client *Client) *IndicesCloseService {
 	return &IndicesCloseService{client: client}
 }
 
-// Index is the name of the index to close.
-func (s *IndicesCloseService) Index(index string) *IndicesCloseService {
-	s.index = index
+// Index is a list of indices to close. It supports wildcards.
+func (s *IndicesCloseService) Index(indices ...string) *IndicesCloseService {
+	s.index = append(s.index, indices...)
 	return s
 }
 
@@ -72,6 +74,13 @@ func (s *IndicesCloseService) ExpandWildcards(expandWildcards string) *IndicesCl
 	return s
 }
 
+// WaitForActiveShards sets the number of shard copies that must be active
+// before the operation returns, e.g. "all" or "1".
+func (s *IndicesCloseService) WaitForActiveShards(waitForActiveShards string) *IndicesCloseService {
+	s.waitForActiveShards = waitForActiveShards
+	return s
+}
+
 // Pretty indicates that the JSON response be indented and human readable.
 func (s *IndicesCloseService) Pretty(pretty bool) *IndicesCloseService {
 	s.pretty = pretty
@@ -82,7 +91,7 @@ func (s *IndicesCloseService) Pretty(pretty bool) *IndicesCloseService {
 func (s *IndicesCloseService) buildURL() (string, url.Values, error) {
 	// Build URL
 	path, err := uritemplates.Expand("/{index}/_close", map[string]string{
-		"index": s.index,
+		"index": strings.Join(s.index, ","),
 	})
 	if err != nil {
 		return "", url.Values{}, err
@@ -105,6 +114,9 @@ func (s *IndicesCloseService) buildURL() (string, url.Values, error) {
 	if s.ignoreUnavailable != nil {
 		params.Set("ignore_unavailable", fmt.Sprintf("%v", *s.ignoreUnavailable))
 	}
+	if s.waitForActiveShards != "" {
+		params.Set("wait_for_active_shards", s.waitForActiveShards)
+	}
 
 	return path, params, nil
 }
@@ -112,7 +124,7 @@ func (s *IndicesCloseService) buildURL() (string, url.Values, error) {
 // Validate checks if the operation is valid.
 func (s *IndicesCloseService) Validate() error {
 	var invalid []string
-	if s.index == "" {
+	if len(s.index) == 0 {
 		invalid = append(invalid, "Index")
 	}
 	if len(invalid) > 0 {
@@ -150,5 +162,6 @@ func (s *IndicesCloseService) Do(ctx context.Context) (*IndicesCloseResponse, er
 
 // IndicesCloseResponse is the response of IndicesCloseService.Do.
 type IndicesCloseResponse struct {
-	Acknowledged bool `json:"acknowledged"`
+	Acknowledged       bool `json:"acknowledged"`
+	ShardsAcknowledged bool `json:"shards_acknowledged"`
 }
@@ -0,0 +1,238 @@
+// Copyright 2012-present Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"golang.org/x/net/context"
+
+	"gopkg.in/olivere/elastic.v5/uritemplates"
+)
+
+// IndicesSyncedFlushService performs a synced flush on one or more indices.
+// A synced flush marks idle shards with a sync id, which speeds up shard
+// recovery after a restart.
+//
+// The synced flush API is only available up to Elasticsearch 7.x. It was
+// removed in 8.0, where Do returns the *Error reported by the server.
+// Use IndicesFlushService instead.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/7.17/indices-synced-flush-api.html
+// for details.
+type IndicesSyncedFlushService struct {
+	client            *Client
+	pretty            bool
+	index             []string
+	ignoreUnavailable *bool
+	allowNoIndices    *bool
+	expandWildcards   string
+}
+
+// NewIndicesSyncedFlushService creates a new IndicesSyncedFlushService.
+func NewIndicesSyncedFlushService(client *Client) *IndicesSyncedFlushService {
+	return &IndicesSyncedFlushService{
+		client: client,
+		index:  make([]string, 0),
+	}
+}
+
+// Index is a list of index names; use `_all` or empty string for all indices.
+func (s *IndicesSyncedFlushService) Index(indices ...string) *IndicesSyncedFlushService {
+	s.index = append(s.index, indices...)
+	return s
+}
+
+// IgnoreUnavailable indicates whether specified concrete indices should be
+// ignored when unavailable (missing or closed).
+func (s *IndicesSyncedFlushService) IgnoreUnavailable(ignoreUnavailable bool) *IndicesSyncedFlushService {
+	s.ignoreUnavailable = &ignoreUnavailable
+	return s
+}
+
+// AllowNoIndices indicates whether to ignore if a wildcard indices expression
+// resolves into no concrete indices. (This includes `_all` string or when
+// no indices have been specified).
+func (s *IndicesSyncedFlushService) AllowNoIndices(allowNoIndices bool) *IndicesSyncedFlushService {
+	s.allowNoIndices = &allowNoIndices
+	return s
+}
+
+// ExpandWildcards specifies whether to expand wildcard expression to
+// concrete indices that are open, closed or both.
+func (s *IndicesSyncedFlushService) ExpandWildcards(expandWildcards string) *IndicesSyncedFlushService {
+	s.expandWildcards = expandWildcards
+	return s
+}
+
+// Pretty indicates that the JSON response be indented and human readable.
+func (s *IndicesSyncedFlushService) Pretty(pretty bool) *IndicesSyncedFlushService {
+	s.pretty = pretty
+	return s
+}
+
+// buildURL builds the URL for the operation.
+func (s *IndicesSyncedFlushService) buildURL() (string, url.Values, error) {
+	// Build URL
+	var err error
+	var path string
+
+	if len(s.index) > 0 {
+		path, err = uritemplates.Expand("/{index}/_flush/synced", map[string]string{
+			"index": strings.Join(s.index, ","),
+		})
+	} else {
+		path = "/_flush/synced"
+	}
+	if err != nil {
+		return "", url.Values{}, err
+	}
+
+	// Add query string parameters
+	params := url.Values{}
+	if s.pretty {
+		params.Set("pretty", "1")
+	}
+	if s.ignoreUnavailable != nil {
+		params.Set("ignore_unavailable", fmt.Sprintf("%v", *s.ignoreUnavailable))
+	}
+	if s.allowNoIndices != nil {
+		params.Set("allow_no_indices", fmt.Sprintf("%v", *s.allowNoIndices))
+	}
+	if s.expandWildcards != "" {
+		params.Set("expand_wildcards", s.expandWildcards)
+	}
+	return path, params, nil
+}
+
+// Validate checks if the operation is valid.
+func (s *IndicesSyncedFlushService) Validate() error {
+	return nil
+}
+
+// Do executes the service.
+//
+// If some shards could not be synced, Elasticsearch responds with HTTP
+// status 409. Do then returns the per-index results together with an
+// *Error of that status. If the cluster does not support synced flush,
+// as is the case as of Elasticsearch 8.0, the *Error says so.
+func (s *IndicesSyncedFlushService) Do(ctx context.Context) (*IndicesSyncedFlushResponse, error) {
+	// Check pre-conditions
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+
+	// Get URL for request
+	path, params, err := s.buildURL()
+	if err != nil {
+		return nil, err
+	}
+
+	// Get HTTP response
+	res, err := s.client.PerformRequest(ctx, "POST", path, params, nil, http.StatusBadRequest, http.StatusConflict)
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode == http.StatusBadRequest {
+		return nil, syncedFlushError(res)
+	}
+
+	// Return operation response
+	ret := new(IndicesSyncedFlushResponse)
+	if err := s.client.decoder.Decode(res.Body, ret); err != nil {
+		return nil, err
+	}
+	if res.StatusCode == http.StatusConflict {
+		return ret, &Error{
+			Status: res.StatusCode,
+			Details: &ErrorDetails{
+				Type:   "synced_flush_failed",
+				Reason: fmt.Sprintf("synced flush failed on %d of %d shards", ret.Shards.Failed, ret.Shards.Total),
+			},
+		}
+	}
+	return ret, nil
+}
+
+// syncedFlushError returns the error for a synced flush that failed with
+// HTTP status 400. Clusters without the synced flush API respond with
+// "no handler found for uri", which is reported as such. Other errors
+// are returned as sent by Elasticsearch.
+func syncedFlushError(res *Response) error {
+	var reply struct {
+		Error interface{} `json:"error"`
+	}
+	if err := json.Unmarshal(res.Body, &reply); err == nil {
+		if msg, ok := reply.Error.(string); ok && strings.HasPrefix(msg, "no handler found for uri") {
+			return &Error{
+				Status: res.StatusCode,
+				Details: &ErrorDetails{
+					Reason: fmt.Sprintf("synced flush is not supported by this cluster (removed in 8.0): %s", msg),
+				},
+			}
+		}
+	}
+	return createResponseError(&http.Response{
+		StatusCode: res.StatusCode,
+		Header:     res.Header,
+		Body:       ioutil.NopCloser(bytes.NewReader(res.Body)),
+	})
+}
+
+// -- Result of a synced flush request.
+
+// IndicesSyncedFlushResponse is the response of IndicesSyncedFlushService.Do.
+// Index maps the index name to the outcome of the synced flush on its shards.
+type IndicesSyncedFlushResponse struct {
+	Shards shardsInfo                                 `json:"_shards"`
+	Index  map[string]*IndicesShardsSyncedFlushResult `json:"-"`
+}
+
+// IndicesShardsSyncedFlushResult is the outcome of a synced flush on the
+// shards of a single index.
+type IndicesShardsSyncedFlushResult struct {
+	Total      int                                     `json:"total"`
+	Successful int                                     `json:"successful"`
+	Failed     int                                     `json:"failed"`
+	Failures   []IndicesShardsSyncedFlushResultFailure `json:"failures,omitempty"`
+}
+
+// IndicesShardsSyncedFlushResultFailure describes a shard that could not
+// be synced.
+type IndicesShardsSyncedFlushResultFailure struct {
+	Shard   int                    `json:"shard"`
+	Reason  string                 `json:"reason"`
+	Routing map[string]interface{} `json:"routing,omitempty"`
+}
+
+// UnmarshalJSON decodes JSON data and initializes an IndicesSyncedFlushResponse
+// structure. The per-index results are top-level keys next to "_shards".
+func (resp *IndicesSyncedFlushResponse) UnmarshalJSON(data []byte) error {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(data, &m); err != nil {
+		return err
+	}
+	resp.Index = make(map[string]*IndicesShardsSyncedFlushResult)
+	for k, v := range m {
+		if k == "_shards" {
+			if err := json.Unmarshal(v, &resp.Shards); err != nil {
+				return err
+			}
+			continue
+		}
+		result := new(IndicesShardsSyncedFlushResult)
+		if err := json.Unmarshal(v, result); err != nil {
+			return err
+		}
+		resp.Index[k] = result
+	}
+	return nil
+}
@@ -0,0 +1,233 @@
+// Copyright 2012-present Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"golang.org/x/net/context"
+)
+
+func TestSyncedFlushBuildURL(t *testing.T) {
+	client := setupTestClient(t)
+
+	tests := []struct {
+		Service       *IndicesSyncedFlushService
+		ExpectedPath  string
+		ExpectedQuery string
+	}{
+		{
+			client.SyncedFlush(),
+			"/_flush/synced",
+			"",
+		},
+		{
+			client.SyncedFlush("index1", "index2"),
+			"/index1%2Cindex2/_flush/synced",
+			"",
+		},
+		{
+			client.SyncedFlush("index*").IgnoreUnavailable(true).AllowNoIndices(true).ExpandWildcards("all"),
+			"/index%2A/_flush/synced",
+			"allow_no_indices=true&expand_wildcards=all&ignore_unavailable=true",
+		},
+	}
+
+	for i, test := range tests {
+		path, params, err := test.Service.buildURL()
+		if err != nil {
+			t.Fatalf("case #%d: %v", i+1, err)
+		}
+		if want, have := test.ExpectedPath, path; want != have {
+			t.Errorf("case #%d: expected path %q; got: %q", i+1, want, have)
+		}
+		if want, have := test.ExpectedQuery, params.Encode(); want != have {
+			t.Errorf("case #%d: expected query %q; got: %q", i+1, want, have)
+		}
+	}
+}
+
+func TestSyncedFlushResponse(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if want, have := "POST", r.Method; want != have {
+			t.Errorf("expected method %q; got: %q", want, have)
+		}
+		if want, have := "/twitter/_flush/synced", r.URL.Path; want != have {
+			t.Errorf("expected path %q; got: %q", want, have)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{
+			"_shards": {"total": 4, "successful": 3, "failed": 1},
+			"twitter": {
+				"total": 4,
+				"successful": 3,
+				"failed": 1,
+				"failures": [
+					{
+						"shard": 1,
+						"reason": "[2] ongoing operations on primary",
+						"routing": {"state": "STARTED", "primary": true, "node": "node-a"}
+					}
+				]
+			}
+		}`)
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(SetURL(ts.URL), SetSniff(false), SetHealthcheck(false), SetMaxRetries(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := client.SyncedFlush("twitter").Do(context.TODO())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, have := 3, res.Shards.Successful; want != have {
+		t.Errorf("expected %d successful shards; got: %d", want, have)
+	}
+	if want, have := 1, len(res.Index); want != have {
+		t.Fatalf("expected %d index results; got: %d", want, have)
+	}
+	result, ok := res.Index["twitter"]
+	if !ok {
+		t.Fatal("expected result for index twitter")
+	}
+	if want, have := 1, result.Failed; want != have {
+		t.Errorf("expected %d failed shards; got: %d", want, have)
+	}
+	if want, have := 1, len(result.Failures); want != have {
+		t.Fatalf("expected %d failures; got: %d", want, have)
+	}
+	if want, have := "[2] ongoing operations on primary", result.Failures[0].Reason; want != have {
+		t.Errorf("expected reason %q; got: %q", want, have)
+	}
+}
+
+func TestSyncedFlushUnsupported(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, `{"error":"no handler found for uri [%s] and method [%s]","status":400}`, r.URL.Path, r.Method)
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(SetURL(ts.URL), SetSniff(false), SetHealthcheck(false), SetMaxRetries(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := client.SyncedFlush("twitter").Do(context.TODO())
+	if err == nil {
+		t.Fatal("expected error from a cluster without the synced flush API")
+	}
+	if res != nil {
+		t.Fatalf("expected result to be == nil; got: %v", res)
+	}
+	e, ok := err.(*Error)
+	if !ok {
+		t.Fatalf("expected error of type *Error; got: %T", err)
+	}
+	if want, have := http.StatusBadRequest, e.Status; want != have {
+		t.Errorf("expected status %d; got: %d", want, have)
+	}
+	if want, have := "synced flush is not supported by this cluster (removed in 8.0)", err.Error(); !strings.Contains(have, want) {
+		t.Errorf("expected error to contain %q; got: %q", want, have)
+	}
+}
+
+func TestSyncedFlushErrors(t *testing.T) {
+	tests := []struct {
+		Status      int
+		Body        string
+		Unsupported bool
+		Type        string // expected error type, if any
+	}{
+		{http.StatusBadRequest, `{"error":"no handler found for uri [/twitter/_flush/synced] and method [POST]","status":400}`, true, ""},
+		{http.StatusBadRequest, `{"error":{"type":"illegal_argument_exception","reason":"request [/twitter/_flush/synced] contains unrecognized parameter: [x]"},"status":400}`, false, "illegal_argument_exception"},
+		{http.StatusMethodNotAllowed, `{"error":"Incorrect HTTP method for uri [/twitter/_flush/synced] and method [POST], allowed: [GET]","status":405}`, false, ""},
+		{http.StatusNotFound, `{"error":{"type":"index_not_found_exception","reason":"no such index"},"status":404}`, false, "index_not_found_exception"},
+	}
+
+	for i, test := range tests {
+		status, body := test.Status, test.Body
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(status)
+			fmt.Fprint(w, body)
+		}))
+
+		client, err := NewClient(SetURL(ts.URL), SetSniff(false), SetHealthcheck(false), SetMaxRetries(0))
+		if err != nil {
+			t.Fatal(err)
+		}
+		res, err := client.SyncedFlush("twitter").Do(context.TODO())
+		if err == nil {
+			t.Fatalf("case #%d: expected error", i+1)
+		}
+		if res != nil {
+			t.Errorf("case #%d: expected result to be == nil; got: %v", i+1, res)
+		}
+		if want, have := test.Unsupported, strings.Contains(err.Error(), "synced flush is not supported by this cluster"); want != have {
+			t.Errorf("case #%d: expected unsupported = %v; got: %v (%v)", i+1, want, have, err)
+		}
+		e, ok := err.(*Error)
+		if !ok || e.Status != test.Status {
+			t.Fatalf("case #%d: expected *Error with status %d; got: %v", i+1, test.Status, err)
+		}
+		if test.Type != "" && (e.Details == nil || e.Details.Type != test.Type) {
+			t.Errorf("case #%d: expected error type %q; got: %+v", i+1, test.Type, e.Details)
+		}
+		ts.Close()
+	}
+}
+
+func TestSyncedFlushConflict(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusConflict)
+		fmt.Fprint(w, `{
+			"_shards": {"total": 4, "successful": 3, "failed": 1},
+			"twitter": {
+				"total": 4,
+				"successful": 3,
+				"failed": 1,
+				"failures": [{"shard": 1, "reason": "pending operations"}]
+			}
+		}`)
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(SetURL(ts.URL), SetSniff(false), SetHealthcheck(false), SetMaxRetries(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := client.SyncedFlush("twitter").Do(context.TODO())
+	if err == nil {
+		t.Fatal("expected error on partial failure")
+	}
+	e, ok := err.(*Error)
+	if !ok {
+		t.Fatalf("expected error of type *Error; got: %T", err)
+	}
+	if want, have := http.StatusConflict, e.Status; want != have {
+		t.Errorf("expected status %d; got: %d", want, have)
+	}
+	if res == nil {
+		t.Fatal("expected result alongside the error")
+	}
+	if want, have := 1, res.Shards.Failed; want != have {
+		t.Errorf("expected %d failed shards; got: %d", want, have)
+	}
+	result, ok := res.Index["twitter"]
+	if !ok {
+		t.Fatal("expected result for index twitter")
+	}
+	if want, have := "pending operations", result.Failures[0].Reason; want != have {
+		t.Errorf("expected reason %q; got: %q", want, have)
+	}
+}
@@ -5,6 +5,9 @@
 package elastic
 
 import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"golang.org/x/net/context"
@@ -69,3 +72,77 @@ func TestFlushBuildURL(t *testing.T) {
 		}
 	}
 }
+
+func TestFlushBuildURLParams(t *testing.T) {
+	client := setupTestClient(t)
+
+	tests := []struct {
+		Service       *IndicesFlushService
+		ExpectedPath  string
+		ExpectedQuery string
+	}{
+		{
+			client.Flush("index1").Force(true),
+			"/index1/_flush",
+			"force=true",
+		},
+		{
+			client.Flush("index1").WaitIfOngoing(true),
+			"/index1/_flush",
+			"wait_if_ongoing=true",
+		},
+		{
+			client.Flush().Force(false).WaitIfOngoing(true).IgnoreUnavailable(true).AllowNoIndices(false).ExpandWildcards("open"),
+			"/_flush",
+			"allow_no_indices=false&expand_wildcards=open&force=false&ignore_unavailable=true&wait_if_ongoing=true",
+		},
+	}
+
+	for i, test := range tests {
+		path, params, err := test.Service.buildURL()
+		if err != nil {
+			t.Fatalf("case #%d: %v", i+1, err)
+		}
+		if want, have := test.ExpectedPath, path; want != have {
+			t.Errorf("case #%d: expected path %q; got: %q", i+1, want, have)
+		}
+		if want, have := test.ExpectedQuery, params.Encode(); want != have {
+			t.Errorf("case #%d: expected query %q; got: %q", i+1, want, have)
+		}
+	}
+}
+
+func TestFlushResponse(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if want, have := "POST", r.Method; want != have {
+			t.Errorf("expected method %q; got: %q", want, have)
+		}
+		if want, have := "/twitter/_flush", r.URL.Path; want != have {
+			t.Errorf("expected path %q; got: %q", want, have)
+		}
+		if want, have := "true", r.URL.Query().Get("wait_if_ongoing"); want != have {
+			t.Errorf("expected wait_if_ongoing=%q; got: %q", want, have)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"_shards":{"total":10,"successful":5,"failed":0}}`)
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(SetURL(ts.URL), SetSniff(false), SetHealthcheck(false), SetMaxRetries(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := client.Flush("twitter").WaitIfOngoing(true).Do(context.TODO())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, have := 10, res.Shards.Total; want != have {
+		t.Errorf("expected %d total shards; got: %d", want, have)
+	}
+	if want, have := 5, res.Shards.Successful; want != have {
+		t.Errorf("expected %d successful shards; got: %d", want, have)
+	}
+	if want, have := 0, res.Shards.Failed; want != have {
+		t.Errorf("expected %d failed shards; got: %d", want, have)
+	}
+}
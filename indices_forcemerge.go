@@ -32,6 +32,7 @@ type IndicesForcemergeService struct {
 	maxNumSegments     interface{}
 	onlyExpungeDeletes *bool
 	operationThreading interface{}
+	waitForCompletion  *bool
 }
 
 // NewIndicesForcemergeService creates a new IndicesForcemergeService.
@@ -100,6 +101,13 @@ func (s *IndicesForcemergeService) OperationThreading(operationThreading interfa
 	return s
 }
 
+// WaitForCompletion specifies whether the request should block until
+// the merge is complete (default: true).
+func (s *IndicesForcemergeService) WaitForCompletion(waitForCompletion bool) *IndicesForcemergeService {
+	s.waitForCompletion = &waitForCompletion
+	return s
+}
+
 // Pretty indicates that the JSON response be indented and human readable.
 func (s *IndicesForcemergeService) Pretty(pretty bool) *IndicesForcemergeService {
 	s.pretty = pretty
@@ -149,6 +157,9 @@ func (s *IndicesForcemergeService) buildURL() (string, url.Values, error) {
 	if s.operationThreading != nil {
 		params.Set("operation_threading", fmt.Sprintf("%v", s.operationThreading))
 	}
+	if s.waitForCompletion != nil {
+		params.Set("wait_for_completion", fmt.Sprintf("%v", *s.waitForCompletion))
+	}
 	return path, params, nil
 }
 
@@ -5,6 +5,9 @@
 package elastic
 
 import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"golang.org/x/net/context"
@@ -43,6 +46,55 @@ func TestIndicesForcemergeBuildURL(t *testing.T) {
 	}
 }
 
+func TestIndicesForcemergeBuildURLParams(t *testing.T) {
+	client := setupTestClient(t)
+
+	_, params, err := client.Forcemerge("index1").
+		MaxNumSegments(1).
+		OnlyExpungeDeletes(false).
+		Flush(true).
+		WaitForCompletion(false).
+		buildURL()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := "flush=true&max_num_segments=1&only_expunge_deletes=false&wait_for_completion=false"
+	if got := params.Encode(); got != expected {
+		t.Errorf("expected query %q; got: %q", expected, got)
+	}
+}
+
+func TestIndicesForcemergeAllIndices(t *testing.T) {
+	var method, path string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method, path = r.Method, r.URL.Path
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"_shards":{"total":10,"successful":5,"failed":0}}`)
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(SetURL(ts.URL), SetSniff(false), SetHealthcheck(false), SetMaxRetries(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := client.Forcemerge().MaxNumSegments(1).Do(context.TODO())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, have := "POST", method; want != have {
+		t.Errorf("expected method %q; got: %q", want, have)
+	}
+	if want, have := "/_forcemerge", path; want != have {
+		t.Errorf("expected path %q; got: %q", want, have)
+	}
+	if want, have := 10, res.Shards.Total; want != have {
+		t.Errorf("expected %d total shards; got: %d", want, have)
+	}
+	if want, have := 5, res.Shards.Successful; want != have {
+		t.Errorf("expected %d successful shards; got: %d", want, have)
+	}
+}
+
 func TestIndicesForcemerge(t *testing.T) {
 	client := setupTestClientAndCreateIndexAndAddDocs(t)
 
@@ -0,0 +1,174 @@
+// Copyright 2012-present Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"golang.org/x/net/context"
+
+	"gopkg.in/olivere/elastic.v5/uritemplates"
+)
+
+// IndicesFreezeService freezes one or more indices.
+//
+// The freeze API is only available in Elasticsearch 7.x. It was removed in
+// 8.0, where Do returns the *Error reported by the server.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/7.17/freeze-index-api.html
+// for details.
+type IndicesFreezeService struct {
+	client              *Client
+	pretty              bool
+	index               []string
+	timeout             string
+	masterTimeout       string
+	ignoreUnavailable   *bool
+	allowNoIndices      *bool
+	expandWildcards     string
+	waitForActiveShards string
+}
+
+// NewIndicesFreezeService creates and initializes a new IndicesFreezeService.
+func NewIndicesFreezeService(client *Client) *IndicesFreezeService {
+	return &IndicesFreezeService{client: client}
+}
+
+// Index is a list of indices to freeze. It supports wildcards.
+func (s *IndicesFreezeService) Index(indices ...string) *IndicesFreezeService {
+	s.index = append(s.index, indices...)
+	return s
+}
+
+// Timeout is an explicit operation timeout.
+func (s *IndicesFreezeService) Timeout(timeout string) *IndicesFreezeService {
+	s.timeout = timeout
+	return s
+}
+
+// MasterTimeout specifies the timeout for connection to master.
+func (s *IndicesFreezeService) MasterTimeout(masterTimeout string) *IndicesFreezeService {
+	s.masterTimeout = masterTimeout
+	return s
+}
+
+// IgnoreUnavailable indicates whether specified concrete indices should
+// be ignored when unavailable (missing or closed).
+func (s *IndicesFreezeService) IgnoreUnavailable(ignoreUnavailable bool) *IndicesFreezeService {
+	s.ignoreUnavailable = &ignoreUnavailable
+	return s
+}
+
+// AllowNoIndices indicates whether to ignore if a wildcard indices
+// expression resolves into no concrete indices.
+// (This includes `_all` string or when no indices have been specified).
+func (s *IndicesFreezeService) AllowNoIndices(allowNoIndices bool) *IndicesFreezeService {
+	s.allowNoIndices = &allowNoIndices
+	return s
+}
+
+// ExpandWildcards indicates whether to expand wildcard expression to
+// concrete indices that are open, closed or both..
+func (s *IndicesFreezeService) ExpandWildcards(expandWildcards string) *IndicesFreezeService {
+	s.expandWildcards = expandWildcards
+	return s
+}
+
+// WaitForActiveShards sets the number of shard copies that must be active
+// before the operation returns, e.g. "all" or "1".
+func (s *IndicesFreezeService) WaitForActiveShards(waitForActiveShards string) *IndicesFreezeService {
+	s.waitForActiveShards = waitForActiveShards
+	return s
+}
+
+// Pretty indicates that the JSON response be indented and human readable.
+func (s *IndicesFreezeService) Pretty(pretty bool) *IndicesFreezeService {
+	s.pretty = pretty
+	return s
+}
+
+// buildURL builds the URL for the operation.
+func (s *IndicesFreezeService) buildURL() (string, url.Values, error) {
+	// Build URL
+	path, err := uritemplates.Expand("/{index}/_freeze", map[string]string{
+		"index": strings.Join(s.index, ","),
+	})
+	if err != nil {
+		return "", url.Values{}, err
+	}
+
+	// Add query string parameters
+	params := url.Values{}
+	if s.pretty {
+		params.Set("pretty", "1")
+	}
+	if s.timeout != "" {
+		params.Set("timeout", s.timeout)
+	}
+	if s.masterTimeout != "" {
+		params.Set("master_timeout", s.masterTimeout)
+	}
+	if s.ignoreUnavailable != nil {
+		params.Set("ignore_unavailable", fmt.Sprintf("%v", *s.ignoreUnavailable))
+	}
+	if s.allowNoIndices != nil {
+		params.Set("allow_no_indices", fmt.Sprintf("%v", *s.allowNoIndices))
+	}
+	if s.expandWildcards != "" {
+		params.Set("expand_wildcards", s.expandWildcards)
+	}
+	if s.waitForActiveShards != "" {
+		params.Set("wait_for_active_shards", s.waitForActiveShards)
+	}
+
+	return path, params, nil
+}
+
+// Validate checks if the operation is valid.
+func (s *IndicesFreezeService) Validate() error {
+	var invalid []string
+	if len(s.index) == 0 {
+		invalid = append(invalid, "Index")
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf("missing required fields: %v", invalid)
+	}
+	return nil
+}
+
+// Do executes the operation.
+func (s *IndicesFreezeService) Do(ctx context.Context) (*IndicesFreezeResponse, error) {
+	// Check pre-conditions
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+
+	// Get URL for request
+	path, params, err := s.buildURL()
+	if err != nil {
+		return nil, err
+	}
+
+	// Get HTTP response
+	res, err := s.client.PerformRequest(ctx, "POST", path, params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return operation response
+	ret := new(IndicesFreezeResponse)
+	if err := s.client.decoder.Decode(res.Body, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// IndicesFreezeResponse is the response of IndicesFreezeService.Do.
+type IndicesFreezeResponse struct {
+	Acknowledged       bool `json:"acknowledged"`
+	ShardsAcknowledged bool `json:"shards_acknowledged"`
+}
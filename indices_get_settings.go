@@ -29,6 +29,7 @@ type IndicesGetSettingsService struct {
 	expandWildcards   string
 	flatSettings      *bool
 	local             *bool
+	includeDefaults   *bool
 }
 
 // NewIndicesGetSettingsService creates a new IndicesGetSettingsService.
@@ -89,6 +90,13 @@ func (s *IndicesGetSettingsService) Local(local bool) *IndicesGetSettingsService
 	return s
 }
 
+// IncludeDefaults indicates whether to return all default settings
+// in the response (default: false).
+func (s *IndicesGetSettingsService) IncludeDefaults(includeDefaults bool) *IndicesGetSettingsService {
+	s.includeDefaults = &includeDefaults
+	return s
+}
+
 // Pretty indicates that the JSON response be indented and human readable.
 func (s *IndicesGetSettingsService) Pretty(pretty bool) *IndicesGetSettingsService {
 	s.pretty = pretty
@@ -143,6 +151,9 @@ func (s *IndicesGetSettingsService) buildURL() (string, url.Values, error) {
 	if s.local != nil {
 		params.Set("local", fmt.Sprintf("%v", *s.local))
 	}
+	if s.includeDefaults != nil {
+		params.Set("include_defaults", fmt.Sprintf("%v", *s.includeDefaults))
+	}
 	return path, params, nil
 }
 
@@ -181,4 +192,5 @@ func (s *IndicesGetSettingsService) Do(ctx context.Context) (map[string]*Indices
 // IndicesGetSettingsResponse is the response of IndicesGetSettingsService.Do.
 type IndicesGetSettingsResponse struct {
 	Settings map[string]interface{} `json:"settings"`
+	Defaults map[string]interface{} `json:"defaults,omitempty"` // only with IncludeDefaults
 }
@@ -5,6 +5,9 @@
 package elastic
 
 import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"golang.org/x/net/context"
@@ -81,3 +84,43 @@ func TestIndexGetSettingsService(t *testing.T) {
 		t.Fatalf("expected index settings of %q to be != nil; got: %v", testIndexName, info.Settings)
 	}
 }
+
+func TestIndexGetSettingsWithDefaults(t *testing.T) {
+	var path, query string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path, query = r.URL.Path, r.URL.RawQuery
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{
+			"twitter": {
+				"settings": {"index.number_of_replicas": "1"},
+				"defaults": {"index.refresh_interval": "1s"}
+			}
+		}`)
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(SetURL(ts.URL), SetSniff(false), SetHealthcheck(false), SetMaxRetries(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := client.IndexGetSettings("twitter").FlatSettings(true).IncludeDefaults(true).Do(context.TODO())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, have := "/twitter/_settings", path; want != have {
+		t.Errorf("expected path %q; got: %q", want, have)
+	}
+	if want, have := "flat_settings=true&include_defaults=true", query; want != have {
+		t.Errorf("expected query %q; got: %q", want, have)
+	}
+	info, ok := res["twitter"]
+	if !ok {
+		t.Fatal("expected settings for index twitter")
+	}
+	if want, have := "1", info.Settings["index.number_of_replicas"]; want != have {
+		t.Errorf("expected number_of_replicas %v; got: %v", want, have)
+	}
+	if want, have := "1s", info.Defaults["index.refresh_interval"]; want != have {
+		t.Errorf("expected default refresh_interval %v; got: %v", want, have)
+	}
+}
@@ -7,6 +7,7 @@ package elastic
 import (
 	"fmt"
 	"net/url"
+	"strings"
 
 	"golang.org/x/net/context"
 
@@ -18,14 +19,15 @@ import (
 // See https://www.elastic.co/guide/en/elasticsearch/reference/current/indices-open-close.html
 // for details.
 type IndicesOpenService struct {
-	client            *Client
-	pretty            bool
-	index             string
-	timeout           string
-	masterTimeout     string
-	ignoreUnavailable *bool
-	allowNoIndices    *bool
-	expandWildcards   string
+	client              *Client
+	pretty              bool
+	index               []string
+	timeout             string
+	masterTimeout       string
+	ignoreUnavailable   *bool
+	allowNoIndices      *bool
+	expandWildcards     string
+	waitForActiveShards string
 }
 
 // NewIndicesOpenService creates and initializes a new IndicesOpenService.
@@ -33,9 +35,9 @@ func NewIndicesOpenService(client *Client) *IndicesOpenService {
 	return &IndicesOpenService{client: client}
 }
 
-// Index is the name of the index to open.
-func (s *IndicesOpenService) Index(index string) *IndicesOpenService {
-	s.index = index
+// Index is a list of indices to open. It supports wildcards.
+func (s *IndicesOpenService) Index(indices ...string) *IndicesOpenService {
+	s.index = append(s.index, indices...)
 	return s
 }
 
@@ -73,6 +75,13 @@ func (s *IndicesOpenService) ExpandWildcards(expandWildcards string) *IndicesOpe
 	return s
 }
 
+// WaitForActiveShards sets the number of shard copies that must be active
+// before the operation returns, e.g. "all" or "1".
+func (s *IndicesOpenService) WaitForActiveShards(waitForActiveShards string) *IndicesOpenService {
+	s.waitForActiveShards = waitForActiveShards
+	return s
+}
+
 // Pretty indicates that the JSON response be indented and human readable.
 func (s *IndicesOpenService) Pretty(pretty bool) *IndicesOpenService {
 	s.pretty = pretty
@@ -83,7 +92,7 @@ func (s *IndicesOpenService) Pretty(pretty bool) *IndicesOpenService {
 func (s *IndicesOpenService) buildURL() (string, url.Values, error) {
 	// Build URL
 	path, err := uritemplates.Expand("/{index}/_open", map[string]string{
-		"index": s.index,
+		"index": strings.Join(s.index, ","),
 	})
 	if err != nil {
 		return "", url.Values{}, err
@@ -109,6 +118,9 @@ func (s *IndicesOpenService) buildURL() (string, url.Values, error) {
 	if s.expandWildcards != "" {
 		params.Set("expand_wildcards", s.expandWildcards)
 	}
+	if s.waitForActiveShards != "" {
+		params.Set("wait_for_active_shards", s.waitForActiveShards)
+	}
 
 	return path, params, nil
 }
@@ -116,7 +128,7 @@ func (s *IndicesOpenService) buildURL() (string, url.Values, error) {
 // Validate checks if the operation is valid.
 func (s *IndicesOpenService) Validate() error {
 	var invalid []string
-	if s.index == "" {
+	if len(s.index) == 0 {
 		invalid = append(invalid, "Index")
 	}
 	if len(invalid) > 0 {
@@ -154,5 +166,6 @@ func (s *IndicesOpenService) Do(ctx context.Context) (*IndicesOpenResponse, erro
 
 // IndicesOpenResponse is the response of IndicesOpenService.Do.
 type IndicesOpenResponse struct {
-	Acknowledged bool `json:"acknowledged"`
+	Acknowledged       bool `json:"acknowledged"`
+	ShardsAcknowledged bool `json:"shards_acknowledged"`
 }
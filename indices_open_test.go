@@ -5,6 +5,9 @@
 package elastic
 
 import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"golang.org/x/net/context"
@@ -22,3 +25,78 @@ func TestIndicesOpenValidate(t *testing.T) {
 		t.Fatalf("expected result to be == nil; got: %v", res)
 	}
 }
+
+func TestIndicesOpenBuildURL(t *testing.T) {
+	client := setupTestClient(t)
+
+	tests := []struct {
+		Service       *IndicesOpenService
+		ExpectedPath  string
+		ExpectedQuery string
+	}{
+		{
+			NewIndicesOpenService(client).Index("twitter"),
+			"/twitter/_open",
+			"",
+		},
+		{
+			NewIndicesOpenService(client).Index("twitter", "blog"),
+			"/twitter%2Cblog/_open",
+			"",
+		},
+		{
+			NewIndicesOpenService(client).Index("logs-*").ExpandWildcards("closed").AllowNoIndices(true).IgnoreUnavailable(true),
+			"/logs-%2A/_open",
+			"allow_no_indices=true&expand_wildcards=closed&ignore_unavailable=true",
+		},
+		{
+			NewIndicesOpenService(client).Index("twitter").WaitForActiveShards("all"),
+			"/twitter/_open",
+			"wait_for_active_shards=all",
+		},
+	}
+
+	for i, test := range tests {
+		path, params, err := test.Service.buildURL()
+		if err != nil {
+			t.Fatalf("case #%d: %v", i+1, err)
+		}
+		if want, have := test.ExpectedPath, path; want != have {
+			t.Errorf("case #%d: expected path %q; got: %q", i+1, want, have)
+		}
+		if want, have := test.ExpectedQuery, params.Encode(); want != have {
+			t.Errorf("case #%d: expected query %q; got: %q", i+1, want, have)
+		}
+	}
+}
+
+func TestIndicesOpenResponse(t *testing.T) {
+	var method, path string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method, path = r.Method, r.URL.Path
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"acknowledged":true,"shards_acknowledged":true}`)
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(SetURL(ts.URL), SetSniff(false), SetHealthcheck(false), SetMaxRetries(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := client.OpenIndex("twitter").Do(context.TODO())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, have := "POST", method; want != have {
+		t.Errorf("expected method %q; got: %q", want, have)
+	}
+	if want, have := "/twitter/_open", path; want != have {
+		t.Errorf("expected path %q; got: %q", want, have)
+	}
+	if !res.Acknowledged {
+		t.Error("expected acknowledged to be true")
+	}
+	if !res.ShardsAcknowledged {
+		t.Error("expected shards_acknowledged to be true")
+	}
+}
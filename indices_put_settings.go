@@ -28,6 +28,7 @@ type IndicesPutSettingsService struct {
 	flatSettings      *bool
 	ignoreUnavailable *bool
 	masterTimeout     string
+	preserveExisting  *bool
 	bodyJson          interface{}
 	bodyString        string
 }
@@ -81,6 +82,13 @@ func (s *IndicesPutSettingsService) MasterTimeout(masterTimeout string) *Indices
 	return s
 }
 
+// PreserveExisting indicates whether to keep existing settings unchanged
+// and only add settings that are not set yet (default: false).
+func (s *IndicesPutSettingsService) PreserveExisting(preserveExisting bool) *IndicesPutSettingsService {
+	s.preserveExisting = &preserveExisting
+	return s
+}
+
 // Pretty indicates that the JSON response be indented and human readable.
 func (s *IndicesPutSettingsService) Pretty(pretty bool) *IndicesPutSettingsService {
 	s.pretty = pretty
@@ -136,6 +144,9 @@ func (s *IndicesPutSettingsService) buildURL() (string, url.Values, error) {
 	if s.masterTimeout != "" {
 		params.Set("master_timeout", s.masterTimeout)
 	}
+	if s.preserveExisting != nil {
+		params.Set("preserve_existing", fmt.Sprintf("%v", *s.preserveExisting))
+	}
 	return path, params, nil
 }
 
@@ -5,6 +5,10 @@
 package elastic
 
 import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"golang.org/x/net/context"
@@ -94,3 +98,47 @@ func TestIndicesSettingsLifecycle(t *testing.T) {
 		t.Fatalf("expected refresh_interval = %v; got: %v", want, got)
 	}
 }
+
+func TestIndicesPutSettingsReplicas(t *testing.T) {
+	var method, path, query, body string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			t.Error(err)
+		}
+		method, path, query, body = r.Method, r.URL.Path, r.URL.RawQuery, string(data)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"acknowledged":true}`)
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(SetURL(ts.URL), SetSniff(false), SetHealthcheck(false), SetMaxRetries(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := client.IndexPutSettings("twitter").
+		BodyJson(map[string]interface{}{
+			"index": map[string]interface{}{"number_of_replicas": 2},
+		}).
+		PreserveExisting(true).
+		MasterTimeout("30s").
+		Do(context.TODO())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, have := "PUT", method; want != have {
+		t.Errorf("expected method %q; got: %q", want, have)
+	}
+	if want, have := "/twitter/_settings", path; want != have {
+		t.Errorf("expected path %q; got: %q", want, have)
+	}
+	if want, have := "master_timeout=30s&preserve_existing=true", query; want != have {
+		t.Errorf("expected query %q; got: %q", want, have)
+	}
+	if want, have := `{"index":{"number_of_replicas":2}}`, body; want != have {
+		t.Errorf("expected body %s; got: %s", want, have)
+	}
+	if !res.Acknowledged {
+		t.Error("expected acknowledged to be true")
+	}
+}
@@ -0,0 +1,211 @@
+// Copyright 2012-present Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"golang.org/x/net/context"
+
+	"gopkg.in/olivere/elastic.v5/uritemplates"
+)
+
+// IndicesRecoveryService returns information about ongoing and completed
+// shard recoveries of one or more indices.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/current/indices-recovery.html
+// for details.
+type IndicesRecoveryService struct {
+	client     *Client
+	pretty     bool
+	index      []string
+	activeOnly *bool
+	detailed   *bool
+}
+
+// NewIndicesRecoveryService creates a new IndicesRecoveryService.
+func NewIndicesRecoveryService(client *Client) *IndicesRecoveryService {
+	return &IndicesRecoveryService{
+		client: client,
+		index:  make([]string, 0),
+	}
+}
+
+// Index is a list of index names; use `_all` or empty string for all indices.
+func (s *IndicesRecoveryService) Index(indices ...string) *IndicesRecoveryService {
+	s.index = append(s.index, indices...)
+	return s
+}
+
+// ActiveOnly indicates whether to return only information about shard
+// recoveries that are currently in progress (default: false).
+func (s *IndicesRecoveryService) ActiveOnly(activeOnly bool) *IndicesRecoveryService {
+	s.activeOnly = &activeOnly
+	return s
+}
+
+// Detailed indicates whether to return detailed information about the
+// recovered files of each shard (default: false).
+func (s *IndicesRecoveryService) Detailed(detailed bool) *IndicesRecoveryService {
+	s.detailed = &detailed
+	return s
+}
+
+// Pretty indicates that the JSON response be indented and human readable.
+func (s *IndicesRecoveryService) Pretty(pretty bool) *IndicesRecoveryService {
+	s.pretty = pretty
+	return s
+}
+
+// buildURL builds the URL for the operation.
+func (s *IndicesRecoveryService) buildURL() (string, url.Values, error) {
+	var err error
+	var path string
+
+	// Build URL
+	if len(s.index) > 0 {
+		path, err = uritemplates.Expand("/{index}/_recovery", map[string]string{
+			"index": strings.Join(s.index, ","),
+		})
+	} else {
+		path = "/_recovery"
+	}
+	if err != nil {
+		return "", url.Values{}, err
+	}
+
+	// Add query string parameters
+	params := url.Values{}
+	if s.pretty {
+		params.Set("pretty", "1")
+	}
+	if s.activeOnly != nil {
+		params.Set("active_only", fmt.Sprintf("%v", *s.activeOnly))
+	}
+	if s.detailed != nil {
+		params.Set("detailed", fmt.Sprintf("%v", *s.detailed))
+	}
+	return path, params, nil
+}
+
+// Validate checks if the operation is valid.
+func (s *IndicesRecoveryService) Validate() error {
+	return nil
+}
+
+// Do executes the operation. The result maps the index name to the
+// recovery information of its shards.
+func (s *IndicesRecoveryService) Do(ctx context.Context) (map[string]*IndicesRecoveryResponse, error) {
+	// Check pre-conditions
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+
+	// Get URL for request
+	path, params, err := s.buildURL()
+	if err != nil {
+		return nil, err
+	}
+
+	// Get HTTP response
+	res, err := s.client.PerformRequest(ctx, "GET", path, params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return operation response
+	var ret map[string]*IndicesRecoveryResponse
+	if err := s.client.decoder.Decode(res.Body, &ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// IndicesRecoveryResponse is the recovery information of a single index
+// as returned by IndicesRecoveryService.Do.
+type IndicesRecoveryResponse struct {
+	Shards []*IndicesRecoveryShard `json:"shards"`
+}
+
+// IndicesRecoveryShard is the recovery information of a single shard.
+type IndicesRecoveryShard struct {
+	Id                int                         `json:"id"`
+	Type              string                      `json:"type"`  // e.g. EMPTY_STORE, EXISTING_STORE, PEER, SNAPSHOT
+	Stage             string                      `json:"stage"` // e.g. INIT, INDEX, VERIFY_INDEX, TRANSLOG, FINALIZE, DONE
+	Primary           bool                        `json:"primary"`
+	StartTimeInMillis int64                       `json:"start_time_in_millis"`
+	StopTimeInMillis  int64                       `json:"stop_time_in_millis,omitempty"`
+	TotalTimeInMillis int64                       `json:"total_time_in_millis"`
+	Source            *IndicesRecoveryNode        `json:"source,omitempty"`
+	Target            *IndicesRecoveryNode        `json:"target,omitempty"`
+	Index             *IndicesRecoveryIndex       `json:"index,omitempty"`
+	Translog          *IndicesRecoveryTranslog    `json:"translog,omitempty"`
+	VerifyIndex       *IndicesRecoveryVerifyIndex `json:"verify_index,omitempty"`
+}
+
+// IndicesRecoveryNode is the source or target of a shard recovery.
+// Recoveries from a snapshot have a source with Repository, Snapshot,
+// Version, and Index instead of node information.
+type IndicesRecoveryNode struct {
+	Id               string `json:"id,omitempty"`
+	Host             string `json:"host,omitempty"`
+	TransportAddress string `json:"transport_address,omitempty"`
+	Ip               string `json:"ip,omitempty"`
+	Name             string `json:"name,omitempty"`
+	Repository       string `json:"repository,omitempty"`
+	Snapshot         string `json:"snapshot,omitempty"`
+	Version          string `json:"version,omitempty"`
+	Index            string `json:"index,omitempty"`
+}
+
+// IndicesRecoveryIndex is the progress of recovering the files of a shard.
+type IndicesRecoveryIndex struct {
+	Size                       IndicesRecoverySize  `json:"size"`
+	Files                      IndicesRecoveryFiles `json:"files"`
+	TotalTimeInMillis          int64                `json:"total_time_in_millis"`
+	SourceThrottleTimeInMillis int64                `json:"source_throttle_time_in_millis"`
+	TargetThrottleTimeInMillis int64                `json:"target_throttle_time_in_millis"`
+}
+
+// IndicesRecoverySize is the number of bytes recovered for a shard.
+type IndicesRecoverySize struct {
+	TotalInBytes     int64  `json:"total_in_bytes"`
+	ReusedInBytes    int64  `json:"reused_in_bytes"`
+	RecoveredInBytes int64  `json:"recovered_in_bytes"`
+	Percent          string `json:"percent"` // e.g. "42.5%"
+}
+
+// IndicesRecoveryFiles is the number of files recovered for a shard.
+type IndicesRecoveryFiles struct {
+	Total     int64                       `json:"total"`
+	Reused    int64                       `json:"reused"`
+	Recovered int64                       `json:"recovered"`
+	Percent   string                      `json:"percent"`           // e.g. "42.5%"
+	Details   []IndicesRecoveryFileDetail `json:"details,omitempty"` // only with Detailed
+}
+
+// IndicesRecoveryFileDetail is the progress of recovering a single file.
+type IndicesRecoveryFileDetail struct {
+	Name             string `json:"name"`
+	LengthInBytes    int64  `json:"length_in_bytes"`
+	RecoveredInBytes int64  `json:"recovered_in_bytes"`
+}
+
+// IndicesRecoveryTranslog is the progress of replaying the transaction log.
+type IndicesRecoveryTranslog struct {
+	Recovered         int64  `json:"recovered"`
+	Total             int64  `json:"total"`
+	Percent           string `json:"percent"`
+	TotalOnStart      int64  `json:"total_on_start"`
+	TotalTimeInMillis int64  `json:"total_time_in_millis"`
+}
+
+// IndicesRecoveryVerifyIndex is the time spent verifying the index.
+type IndicesRecoveryVerifyIndex struct {
+	CheckIndexTimeInMillis int64 `json:"check_index_time_in_millis"`
+	TotalTimeInMillis      int64 `json:"total_time_in_millis"`
+}
@@ -0,0 +1,174 @@
+// Copyright 2012-present Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"golang.org/x/net/context"
+)
+
+func TestIndicesRecoveryBuildURL(t *testing.T) {
+	client := setupTestClient(t)
+
+	tests := []struct {
+		Service       *IndicesRecoveryService
+		ExpectedPath  string
+		ExpectedQuery string
+	}{
+		{
+			client.IndexRecovery(),
+			"/_recovery",
+			"",
+		},
+		{
+			client.IndexRecovery("index1", "index2"),
+			"/index1%2Cindex2/_recovery",
+			"",
+		},
+		{
+			client.IndexRecovery("index1").ActiveOnly(true),
+			"/index1/_recovery",
+			"active_only=true",
+		},
+		{
+			client.IndexRecovery().ActiveOnly(false).Detailed(true),
+			"/_recovery",
+			"active_only=false&detailed=true",
+		},
+	}
+
+	for i, test := range tests {
+		path, params, err := test.Service.buildURL()
+		if err != nil {
+			t.Fatalf("case #%d: %v", i+1, err)
+		}
+		if want, have := test.ExpectedPath, path; want != have {
+			t.Errorf("case #%d: expected path %q; got: %q", i+1, want, have)
+		}
+		if want, have := test.ExpectedQuery, params.Encode(); want != have {
+			t.Errorf("case #%d: expected query %q; got: %q", i+1, want, have)
+		}
+	}
+}
+
+func TestIndicesRecoveryInProgress(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{
+			"twitter": {
+				"shards": [
+					{
+						"id": 0,
+						"type": "PEER",
+						"stage": "INDEX",
+						"primary": false,
+						"start_time_in_millis": 1700000000000,
+						"total_time_in_millis": 5321,
+						"source": {
+							"id": "node-a",
+							"host": "10.0.0.1",
+							"transport_address": "10.0.0.1:9300",
+							"ip": "10.0.0.1",
+							"name": "es-1"
+						},
+						"target": {
+							"id": "node-b",
+							"host": "10.0.0.2",
+							"transport_address": "10.0.0.2:9300",
+							"ip": "10.0.0.2",
+							"name": "es-2"
+						},
+						"index": {
+							"size": {
+								"total_in_bytes": 4000,
+								"reused_in_bytes": 0,
+								"recovered_in_bytes": 1700,
+								"percent": "42.5%"
+							},
+							"files": {
+								"total": 10,
+								"reused": 0,
+								"recovered": 4,
+								"percent": "40.0%"
+							},
+							"total_time_in_millis": 5200,
+							"source_throttle_time_in_millis": 0,
+							"target_throttle_time_in_millis": 12
+						},
+						"translog": {
+							"recovered": 0,
+							"total": -1,
+							"percent": "-1.0%",
+							"total_on_start": -1,
+							"total_time_in_millis": 0
+						},
+						"verify_index": {
+							"check_index_time_in_millis": 0,
+							"total_time_in_millis": 0
+						}
+					}
+				]
+			}
+		}`)
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(SetURL(ts.URL), SetSniff(false), SetHealthcheck(false), SetMaxRetries(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := client.IndexRecovery("twitter").ActiveOnly(true).Do(context.TODO())
+	if err != nil {
+		t.Fatal(err)
+	}
+	info, ok := res["twitter"]
+	if !ok {
+		t.Fatal("expected recovery information for index twitter")
+	}
+	if want, have := 1, len(info.Shards); want != have {
+		t.Fatalf("expected %d shards; got: %d", want, have)
+	}
+	shard := info.Shards[0]
+	if want, have := "PEER", shard.Type; want != have {
+		t.Errorf("expected type %q; got: %q", want, have)
+	}
+	if want, have := "INDEX", shard.Stage; want != have {
+		t.Errorf("expected stage %q; got: %q", want, have)
+	}
+	if shard.Primary {
+		t.Error("expected replica shard")
+	}
+	if shard.Source == nil || shard.Source.Name != "es-1" {
+		t.Errorf("expected source node es-1; got: %+v", shard.Source)
+	}
+	if shard.Target == nil || shard.Target.TransportAddress != "10.0.0.2:9300" {
+		t.Errorf("expected target transport address 10.0.0.2:9300; got: %+v", shard.Target)
+	}
+	if shard.Index == nil {
+		t.Fatal("expected index recovery information")
+	}
+	if want, have := int64(4000), shard.Index.Size.TotalInBytes; want != have {
+		t.Errorf("expected total_in_bytes %d; got: %d", want, have)
+	}
+	if want, have := int64(1700), shard.Index.Size.RecoveredInBytes; want != have {
+		t.Errorf("expected recovered_in_bytes %d; got: %d", want, have)
+	}
+	if want, have := "42.5%", shard.Index.Size.Percent; want != have {
+		t.Errorf("expected size percent %q; got: %q", want, have)
+	}
+	if want, have := int64(4), shard.Index.Files.Recovered; want != have {
+		t.Errorf("expected %d recovered files; got: %d", want, have)
+	}
+	if want, have := int64(12), shard.Index.TargetThrottleTimeInMillis; want != have {
+		t.Errorf("expected target_throttle_time_in_millis %d; got: %d", want, have)
+	}
+	if shard.Translog == nil || shard.Translog.Total != -1 {
+		t.Errorf("expected translog total -1; got: %+v", shard.Translog)
+	}
+}
@@ -0,0 +1,161 @@
+// Copyright 2012-present Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"golang.org/x/net/context"
+
+	"gopkg.in/olivere/elastic.v5/uritemplates"
+)
+
+// IndicesSegmentsService provides low level information about the Lucene
+// segments a shard index is built with. It is useful for diagnosing
+// merges.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/current/indices-segments.html
+// for details.
+type IndicesSegmentsService struct {
+	client  *Client
+	pretty  bool
+	index   []string
+	verbose *bool
+}
+
+// NewIndicesSegmentsService creates a new IndicesSegmentsService.
+func NewIndicesSegmentsService(client *Client) *IndicesSegmentsService {
+	return &IndicesSegmentsService{
+		client: client,
+		index:  make([]string, 0),
+	}
+}
+
+// Index is a list of index names; use `_all` or empty string for all indices.
+func (s *IndicesSegmentsService) Index(indices ...string) *IndicesSegmentsService {
+	s.index = append(s.index, indices...)
+	return s
+}
+
+// Verbose indicates whether to include detailed memory usage
+// information of the segments (default: false).
+func (s *IndicesSegmentsService) Verbose(verbose bool) *IndicesSegmentsService {
+	s.verbose = &verbose
+	return s
+}
+
+// Pretty indicates that the JSON response be indented and human readable.
+func (s *IndicesSegmentsService) Pretty(pretty bool) *IndicesSegmentsService {
+	s.pretty = pretty
+	return s
+}
+
+// buildURL builds the URL for the operation.
+func (s *IndicesSegmentsService) buildURL() (string, url.Values, error) {
+	var err error
+	var path string
+
+	// Build URL
+	if len(s.index) > 0 {
+		path, err = uritemplates.Expand("/{index}/_segments", map[string]string{
+			"index": strings.Join(s.index, ","),
+		})
+	} else {
+		path = "/_segments"
+	}
+	if err != nil {
+		return "", url.Values{}, err
+	}
+
+	// Add query string parameters
+	params := url.Values{}
+	if s.pretty {
+		params.Set("pretty", "1")
+	}
+	if s.verbose != nil {
+		params.Set("verbose", fmt.Sprintf("%v", *s.verbose))
+	}
+	return path, params, nil
+}
+
+// Validate checks if the operation is valid.
+func (s *IndicesSegmentsService) Validate() error {
+	return nil
+}
+
+// Do executes the operation.
+func (s *IndicesSegmentsService) Do(ctx context.Context) (*IndicesSegmentsResponse, error) {
+	// Check pre-conditions
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+
+	// Get URL for request
+	path, params, err := s.buildURL()
+	if err != nil {
+		return nil, err
+	}
+
+	// Get HTTP response
+	res, err := s.client.PerformRequest(ctx, "GET", path, params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return operation response
+	ret := new(IndicesSegmentsResponse)
+	if err := s.client.decoder.Decode(res.Body, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// IndicesSegmentsResponse is the response of IndicesSegmentsService.Do.
+type IndicesSegmentsResponse struct {
+	Shards  shardsInfo                               `json:"_shards"`
+	Indices map[string]*IndicesSegmentsIndexSegments `json:"indices"`
+}
+
+// IndicesSegmentsIndexSegments are the segments of a single index.
+// Shards maps the shard number to the segments of each copy of the shard.
+type IndicesSegmentsIndexSegments struct {
+	Shards map[string][]*IndicesSegmentsShard `json:"shards"`
+}
+
+// IndicesSegmentsShard are the segments of a single shard copy.
+// Segments maps the segment name, e.g. "_0", to its details.
+type IndicesSegmentsShard struct {
+	Routing              *IndicesSegmentsRouting            `json:"routing,omitempty"`
+	NumCommittedSegments int                                `json:"num_committed_segments"`
+	NumSearchSegments    int                                `json:"num_search_segments"`
+	Segments             map[string]*IndicesSegmentsSegment `json:"segments"`
+}
+
+// IndicesSegmentsRouting describes where a shard copy is allocated.
+type IndicesSegmentsRouting struct {
+	State          string `json:"state"`
+	Primary        bool   `json:"primary"`
+	Node           string `json:"node"`
+	RelocatingNode string `json:"relocating_node,omitempty"`
+}
+
+// IndicesSegmentsSegment describes a single Lucene segment.
+type IndicesSegmentsSegment struct {
+	Generation    int64                  `json:"generation"`
+	NumDocs       int64                  `json:"num_docs"`
+	DeletedDocs   int64                  `json:"deleted_docs"`
+	SizeInBytes   int64                  `json:"size_in_bytes"`
+	MemoryInBytes int64                  `json:"memory_in_bytes"`
+	Committed     bool                   `json:"committed"`
+	Search        bool                   `json:"search"`
+	Version       string                 `json:"version"`
+	Compound      bool                   `json:"compound"`
+	MergeId       string                 `json:"merge_id,omitempty"`
+	Sort          []interface{}          `json:"sort,omitempty"`
+	Attributes    map[string]interface{} `json:"attributes,omitempty"`
+	RAMTree       []interface{}          `json:"ram_tree,omitempty"` // only with Verbose
+}
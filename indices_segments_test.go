@@ -0,0 +1,158 @@
+// Copyright 2012-present Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"golang.org/x/net/context"
+)
+
+func TestIndicesSegmentsBuildURL(t *testing.T) {
+	client := setupTestClient(t)
+
+	tests := []struct {
+		Service       *IndicesSegmentsService
+		ExpectedPath  string
+		ExpectedQuery string
+	}{
+		{
+			client.IndexSegments(),
+			"/_segments",
+			"",
+		},
+		{
+			client.IndexSegments("index1"),
+			"/index1/_segments",
+			"",
+		},
+		{
+			client.IndexSegments("index1", "index2").Verbose(true),
+			"/index1%2Cindex2/_segments",
+			"verbose=true",
+		},
+	}
+
+	for i, test := range tests {
+		path, params, err := test.Service.buildURL()
+		if err != nil {
+			t.Fatalf("case #%d: %v", i+1, err)
+		}
+		if want, have := test.ExpectedPath, path; want != have {
+			t.Errorf("case #%d: expected path %q; got: %q", i+1, want, have)
+		}
+		if want, have := test.ExpectedQuery, params.Encode(); want != have {
+			t.Errorf("case #%d: expected query %q; got: %q", i+1, want, have)
+		}
+	}
+}
+
+func TestIndicesSegmentsTwoSegmentShard(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{
+			"_shards": {"total": 1, "successful": 1, "failed": 0},
+			"indices": {
+				"twitter": {
+					"shards": {
+						"0": [
+							{
+								"routing": {"state": "STARTED", "primary": true, "node": "node-a"},
+								"num_committed_segments": 1,
+								"num_search_segments": 2,
+								"segments": {
+									"_0": {
+										"generation": 0,
+										"num_docs": 100,
+										"deleted_docs": 3,
+										"size_in_bytes": 12345,
+										"memory_in_bytes": 1024,
+										"committed": true,
+										"search": true,
+										"version": "6.6.0",
+										"compound": false
+									},
+									"_1": {
+										"generation": 1,
+										"num_docs": 7,
+										"deleted_docs": 0,
+										"size_in_bytes": 3800,
+										"memory_in_bytes": 512,
+										"committed": false,
+										"search": true,
+										"version": "6.6.0",
+										"compound": true
+									}
+								}
+							}
+						]
+					}
+				}
+			}
+		}`)
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(SetURL(ts.URL), SetSniff(false), SetHealthcheck(false), SetMaxRetries(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := client.IndexSegments("twitter").Do(context.TODO())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, have := 1, res.Shards.Successful; want != have {
+		t.Errorf("expected %d successful shards; got: %d", want, have)
+	}
+	index, ok := res.Indices["twitter"]
+	if !ok {
+		t.Fatal("expected segments for index twitter")
+	}
+	copies, ok := index.Shards["0"]
+	if !ok || len(copies) != 1 {
+		t.Fatalf("expected 1 copy of shard 0; got: %v", copies)
+	}
+	shard := copies[0]
+	if shard.Routing == nil || !shard.Routing.Primary || shard.Routing.Node != "node-a" {
+		t.Errorf("expected primary routing on node-a; got: %+v", shard.Routing)
+	}
+	if want, have := 2, shard.NumSearchSegments; want != have {
+		t.Errorf("expected %d search segments; got: %d", want, have)
+	}
+	if want, have := 2, len(shard.Segments); want != have {
+		t.Fatalf("expected %d segments; got: %d", want, have)
+	}
+
+	seg := shard.Segments["_0"]
+	if seg == nil {
+		t.Fatal("expected segment _0")
+	}
+	if want, have := int64(100), seg.NumDocs; want != have {
+		t.Errorf("expected num_docs %d; got: %d", want, have)
+	}
+	if want, have := int64(3), seg.DeletedDocs; want != have {
+		t.Errorf("expected deleted_docs %d; got: %d", want, have)
+	}
+	if want, have := int64(12345), seg.SizeInBytes; want != have {
+		t.Errorf("expected size_in_bytes %d; got: %d", want, have)
+	}
+	if !seg.Committed || !seg.Search || seg.Compound {
+		t.Errorf("expected segment _0 to be committed, searchable and not compound; got: %+v", seg)
+	}
+
+	seg = shard.Segments["_1"]
+	if seg == nil {
+		t.Fatal("expected segment _1")
+	}
+	if want, have := int64(7), seg.NumDocs; want != have {
+		t.Errorf("expected num_docs %d; got: %d", want, have)
+	}
+	if seg.Committed || !seg.Search || !seg.Compound {
+		t.Errorf("expected segment _1 to be uncommitted, searchable and compound; got: %+v", seg)
+	}
+}
@@ -5,6 +5,7 @@
 package elastic
 
 import (
+	"encoding/json"
 	"testing"
 
 	"golang.org/x/net/context"
@@ -85,3 +86,95 @@ func TestIndexStats(t *testing.T) {
 		t.Fatalf("expected total docs count to be > 0; got: %d", stat.Total.Docs.Count)
 	}
 }
+
+func TestIndexStatsResponse(t *testing.T) {
+	body := `{
+		"_shards": { "total": 10, "successful": 5, "failed": 0 },
+		"_all": {
+			"primaries": {
+				"docs": { "count": 3, "deleted": 0 },
+				"indexing": { "index_total": 3, "index_time_in_millis": 45 }
+			},
+			"total": {
+				"docs": { "count": 3, "deleted": 0 },
+				"indexing": { "index_total": 3, "index_time_in_millis": 45 }
+			}
+		},
+		"indices": {
+			"twitter": {
+				"primaries": {
+					"docs": { "count": 3, "deleted": 1 },
+					"store": { "size_in_bytes": 12345, "throttle_time_in_millis": 0 },
+					"indexing": {
+						"index_total": 3,
+						"index_time_in_millis": 45,
+						"index_current": 0,
+						"delete_total": 1,
+						"delete_time_in_millis": 2,
+						"noop_update_total": 0,
+						"is_throttled": false
+					},
+					"search": {
+						"open_contexts": 0,
+						"query_total": 20,
+						"query_time_in_millis": 9,
+						"query_current": 0,
+						"fetch_total": 18,
+						"fetch_time_in_millis": 3,
+						"fetch_current": 0,
+						"scroll_total": 2,
+						"scroll_time_in_millis": 11,
+						"scroll_current": 0
+					},
+					"merges": { "current": 0, "total": 1, "total_time_in_millis": 7, "total_docs": 4, "total_size_in_bytes": 2048 }
+				}
+			}
+		}
+	}`
+	var res IndicesStatsResponse
+	if err := json.Unmarshal([]byte(body), &res); err != nil {
+		t.Fatal(err)
+	}
+	if res.All == nil || res.All.Total == nil || res.All.Total.Docs == nil {
+		t.Fatalf("expected totals; got: %v", res.All)
+	}
+	if want, have := int64(3), res.All.Total.Docs.Count; want != have {
+		t.Errorf("expected _all docs count = %d; got: %d", want, have)
+	}
+	stats, found := res.Indices["twitter"]
+	if !found || stats.Primaries == nil {
+		t.Fatalf("expected stats for index %q; got: %v", "twitter", res.Indices)
+	}
+	indexing := stats.Primaries.Indexing
+	if indexing == nil {
+		t.Fatal("expected indexing stats")
+	}
+	if want, have := int64(3), indexing.IndexTotal; want != have {
+		t.Errorf("expected index_total = %d; got: %d", want, have)
+	}
+	if want, have := int64(45), indexing.IndexTimeInMillis; want != have {
+		t.Errorf("expected index_time_in_millis = %d; got: %d", want, have)
+	}
+	if want, have := int64(1), indexing.DeleteTotal; want != have {
+		t.Errorf("expected delete_total = %d; got: %d", want, have)
+	}
+	search := stats.Primaries.Search
+	if search == nil {
+		t.Fatal("expected search stats")
+	}
+	if want, have := int64(20), search.QueryTotal; want != have {
+		t.Errorf("expected query_total = %d; got: %d", want, have)
+	}
+	if want, have := int64(18), search.FetchTotal; want != have {
+		t.Errorf("expected fetch_total = %d; got: %d", want, have)
+	}
+	if want, have := int64(2), search.ScrollTotal; want != have {
+		t.Errorf("expected scroll_total = %d; got: %d", want, have)
+	}
+	if stats.Primaries.Store == nil || stats.Primaries.Store.SizeInBytes != 12345 {
+		t.Errorf("expected store size_in_bytes = %d; got: %v", 12345, stats.Primaries.Store)
+	}
+	if stats.Primaries.Merges == nil || stats.Primaries.Merges.TotalDocs != 4 {
+		t.Errorf("expected merges total_docs = %d; got: %v", 4, stats.Primaries.Merges)
+	}
+}
@@ -0,0 +1,122 @@
+// Copyright 2012-present Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"golang.org/x/net/context"
+)
+
+func TestIndicesUnfreezeBuildURL(t *testing.T) {
+	client := setupTestClient(t)
+
+	tests := []struct {
+		Service       *IndicesUnfreezeService
+		ExpectedPath  string
+		ExpectedQuery string
+	}{
+		{
+			client.UnfreezeIndex("twitter"),
+			"/twitter/_unfreeze",
+			"",
+		},
+		{
+			NewIndicesUnfreezeService(client).Index("twitter", "blog"),
+			"/twitter%2Cblog/_unfreeze",
+			"",
+		},
+		{
+			client.UnfreezeIndex("twitter").WaitForActiveShards("1").Timeout("30s"),
+			"/twitter/_unfreeze",
+			"timeout=30s&wait_for_active_shards=1",
+		},
+	}
+
+	for i, test := range tests {
+		path, params, err := test.Service.buildURL()
+		if err != nil {
+			t.Fatalf("case #%d: %v", i+1, err)
+		}
+		if want, have := test.ExpectedPath, path; want != have {
+			t.Errorf("case #%d: expected path %q; got: %q", i+1, want, have)
+		}
+		if want, have := test.ExpectedQuery, params.Encode(); want != have {
+			t.Errorf("case #%d: expected query %q; got: %q", i+1, want, have)
+		}
+	}
+}
+
+func TestIndicesUnfreezeValidate(t *testing.T) {
+	client := setupTestClient(t)
+
+	// No index name -> fail with error
+	res, err := NewIndicesUnfreezeService(client).Do(context.TODO())
+	if err == nil {
+		t.Fatalf("expected IndicesUnfreeze to fail without index name")
+	}
+	if res != nil {
+		t.Fatalf("expected result to be == nil; got: %v", res)
+	}
+}
+
+func TestIndicesUnfreezeDo(t *testing.T) {
+	var method, path string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method, path = r.Method, r.URL.Path
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"acknowledged":true,"shards_acknowledged":true}`)
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(SetURL(ts.URL), SetSniff(false), SetHealthcheck(false), SetMaxRetries(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := client.UnfreezeIndex("twitter").Do(context.TODO())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, have := "POST", method; want != have {
+		t.Errorf("expected method %q; got: %q", want, have)
+	}
+	if want, have := "/twitter/_unfreeze", path; want != have {
+		t.Errorf("expected path %q; got: %q", want, have)
+	}
+	if !res.Acknowledged || !res.ShardsAcknowledged {
+		t.Errorf("expected acknowledged and shards_acknowledged to be true; got: %+v", res)
+	}
+}
+
+func TestIndicesUnfreezeUnsupported(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, `{"error":"no handler found for uri [%s] and method [%s]","status":400}`, r.URL.Path, r.Method)
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(SetURL(ts.URL), SetSniff(false), SetHealthcheck(false), SetMaxRetries(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := client.UnfreezeIndex("twitter").Do(context.TODO())
+	if err == nil {
+		t.Fatal("expected error from a cluster without the unfreeze API")
+	}
+	if res != nil {
+		t.Fatalf("expected result to be == nil; got: %v", res)
+	}
+	e, ok := err.(*Error)
+	if !ok {
+		t.Fatalf("expected error of type *Error; got: %T", err)
+	}
+	if want, have := http.StatusBadRequest, e.Status; want != have {
+		t.Errorf("expected status %d; got: %d", want, have)
+	}
+}
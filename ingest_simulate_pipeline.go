@@ -6,6 +6,7 @@ package elastic
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/url"
 
@@ -24,6 +25,8 @@ type IngestSimulatePipelineService struct {
 	pretty     bool
 	id         string
 	verbose    *bool
+	pipeline   interface{}
+	docs       []IngestSimulateDocument
 	bodyJson   interface{}
 	bodyString string
 }
@@ -35,7 +38,8 @@ func NewIngestSimulatePipelineService(client *Client) *IngestSimulatePipelineSer
 	}
 }
 
-// Id specifies the pipeline ID.
+// Id specifies the pipeline ID. Use it to simulate a pipeline that is
+// already stored in the cluster.
 func (s *IngestSimulatePipelineService) Id(id string) *IngestSimulatePipelineService {
 	s.id = id
 	return s
@@ -53,6 +57,20 @@ func (s *IngestSimulatePipelineService) Pretty(pretty bool) *IngestSimulatePipel
 	return s
 }
 
+// Pipeline specifies an inline pipeline definition to simulate, e.g. a
+// map[string]interface{} with "description" and "processors". Do not use
+// it together with Id.
+func (s *IngestSimulatePipelineService) Pipeline(pipeline interface{}) *IngestSimulatePipelineService {
+	s.pipeline = pipeline
+	return s
+}
+
+// Docs specifies the documents to run through the pipeline.
+func (s *IngestSimulatePipelineService) Docs(docs []IngestSimulateDocument) *IngestSimulatePipelineService {
+	s.docs = append(s.docs, docs...)
+	return s
+}
+
 // BodyJson is the ingest definition, defined as a JSON-serializable simulate
 // definition. Use e.g. a map[string]interface{} here.
 func (s *IngestSimulatePipelineService) BodyJson(body interface{}) *IngestSimulatePipelineService {
@@ -97,15 +115,34 @@ func (s *IngestSimulatePipelineService) buildURL() (string, url.Values, error) {
 // Validate checks if the operation is valid.
 func (s *IngestSimulatePipelineService) Validate() error {
 	var invalid []string
-	if s.bodyString == "" && s.bodyJson == nil {
-		invalid = append(invalid, "BodyJson")
+	if s.bodyString == "" && s.bodyJson == nil && len(s.docs) == 0 {
+		invalid = append(invalid, "Docs")
 	}
 	if len(invalid) > 0 {
 		return fmt.Errorf("missing required fields: %v", invalid)
 	}
+	if s.id != "" && s.pipeline != nil {
+		return errors.New("elastic: use either Id or Pipeline with IngestSimulatePipelineService but not both")
+	}
 	return nil
 }
 
+// getBody returns the body of the request.
+func (s *IngestSimulatePipelineService) getBody() interface{} {
+	if s.bodyJson != nil {
+		return s.bodyJson
+	}
+	if s.bodyString != "" {
+		return s.bodyString
+	}
+	body := make(map[string]interface{})
+	if s.pipeline != nil {
+		body["pipeline"] = s.pipeline
+	}
+	body["docs"] = s.docs
+	return body
+}
+
 // Do executes the operation.
 func (s *IngestSimulatePipelineService) Do(ctx context.Context) (*IngestSimulatePipelineResponse, error) {
 	// Check pre-conditions
@@ -119,16 +156,8 @@ func (s *IngestSimulatePipelineService) Do(ctx context.Context) (*IngestSimulate
 		return nil, err
 	}
 
-	// Setup HTTP request body
-	var body interface{}
-	if s.bodyJson != nil {
-		body = s.bodyJson
-	} else {
-		body = s.bodyString
-	}
-
 	// Get HTTP response
-	res, err := s.client.PerformRequest(ctx, "POST", path, params, body)
+	res, err := s.client.PerformRequest(ctx, "POST", path, params, s.getBody())
 	if err != nil {
 		return nil, err
 	}
@@ -146,12 +175,29 @@ type IngestSimulatePipelineResponse struct {
 	Docs []*IngestSimulateDocumentResult `json:"docs"`
 }
 
+// IngestSimulateDocument is a document to run through the pipeline
+// with IngestSimulatePipelineService.
+type IngestSimulateDocument struct {
+	Index  string      `json:"_index,omitempty"`
+	Type   string      `json:"_type,omitempty"`
+	Id     string      `json:"_id,omitempty"`
+	Source interface{} `json:"_source"`
+}
+
+// IngestSimulateDocumentResult is the result for a single document.
+// Doc is the document after it has been processed by the pipeline, i.e.
+// Doc["_source"] contains the processed source. In verbose mode,
+// ProcessorResults contains the result of each processor step instead.
 type IngestSimulateDocumentResult struct {
 	Doc              map[string]interface{}           `json:"doc"`
 	ProcessorResults []*IngestSimulateProcessorResult `json:"processor_results"`
+	Error            *ErrorDetails                    `json:"error,omitempty"`
 }
 
+// IngestSimulateProcessorResult is the result of a single processor
+// in verbose mode.
 type IngestSimulateProcessorResult struct {
 	ProcessorTag string                 `json:"tag"`
 	Doc          map[string]interface{} `json:"doc"`
+	Error        *ErrorDetails          `json:"error,omitempty"`
 }
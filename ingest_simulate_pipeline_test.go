@@ -4,7 +4,10 @@
 
 package elastic
 
-import "testing"
+import (
+	"encoding/json"
+	"testing"
+)
 
 func TestIngestSimulatePipelineURL(t *testing.T) {
 	client := setupTestClientAndCreateIndex(t)
@@ -33,3 +36,149 @@ func TestIngestSimulatePipelineURL(t *testing.T) {
 		}
 	}
 }
+
+func TestIngestSimulatePipelineInlineBody(t *testing.T) {
+	client := setupTestClient(t)
+
+	pipeline := map[string]interface{}{
+		"description": "_description",
+		"processors": []interface{}{
+			map[string]interface{}{
+				"set": map[string]interface{}{
+					"field": "field2",
+					"value": "_value",
+				},
+			},
+		},
+	}
+	svc := client.IngestSimulatePipeline().
+		Pipeline(pipeline).
+		Docs([]IngestSimulateDocument{
+			{Index: "index", Type: "type", Id: "id", Source: map[string]interface{}{"foo": "bar"}},
+		})
+	if err := svc.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(svc.getBody())
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"docs":[{"_index":"index","_type":"type","_id":"id","_source":{"foo":"bar"}}],"pipeline":{"description":"_description","processors":[{"set":{"field":"field2","value":"_value"}}]}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
+
+func TestIngestSimulatePipelineStoredPipeline(t *testing.T) {
+	client := setupTestClient(t)
+
+	svc := client.IngestSimulatePipeline().
+		Id("my-pipeline-id").
+		Verbose(true).
+		Docs([]IngestSimulateDocument{
+			{Source: map[string]interface{}{"foo": "bar"}},
+		})
+	if err := svc.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	path, params, err := svc.buildURL()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, have := "/_ingest/pipeline/my-pipeline-id/_simulate", path; want != have {
+		t.Errorf("expected %q; got: %q", want, have)
+	}
+	if want, have := "true", params.Get("verbose"); want != have {
+		t.Errorf("expected verbose = %q; got: %q", want, have)
+	}
+	data, err := json.Marshal(svc.getBody())
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"docs":[{"_source":{"foo":"bar"}}]}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+
+	// Id and inline pipeline are mutually exclusive
+	if err := svc.Pipeline(map[string]interface{}{}).Validate(); err == nil {
+		t.Error("expected Validate to fail with both Id and Pipeline")
+	}
+}
+
+func TestIngestSimulatePipelineResponse(t *testing.T) {
+	body := `{
+		"docs": [{
+			"doc": {
+				"_id": "id",
+				"_index": "index",
+				"_type": "type",
+				"_source": { "field2": "_value", "foo": "bar" },
+				"_ingest": { "timestamp": "2016-11-08T19:43:03.850+0000" }
+			}
+		}]
+	}`
+	var res IngestSimulatePipelineResponse
+	if err := json.Unmarshal([]byte(body), &res); err != nil {
+		t.Fatal(err)
+	}
+	if want, have := 1, len(res.Docs); want != have {
+		t.Fatalf("expected %d docs; got: %d", want, have)
+	}
+	source, ok := res.Docs[0].Doc["_source"].(map[string]interface{})
+	if !ok {
+		t.Fatalf("expected doc to have a _source; got: %v", res.Docs[0].Doc)
+	}
+	if want, have := "_value", source["field2"]; want != have {
+		t.Errorf("expected field2 = %v; got: %v", want, have)
+	}
+}
+
+func TestIngestSimulatePipelineVerboseResponse(t *testing.T) {
+	body := `{
+		"docs": [{
+			"processor_results": [{
+				"tag": "processor[set]-0",
+				"doc": {
+					"_id": "id",
+					"_index": "index",
+					"_type": "type",
+					"_source": { "field2": "_value", "foo": "bar" },
+					"_ingest": { "timestamp": "2016-11-08T19:43:03.850+0000" }
+				}
+			}, {
+				"tag": "processor[set]-1",
+				"doc": {
+					"_id": "id",
+					"_index": "index",
+					"_type": "type",
+					"_source": { "field3": "_value3", "field2": "_value", "foo": "bar" },
+					"_ingest": { "timestamp": "2016-11-08T19:43:03.850+0000" }
+				}
+			}]
+		}]
+	}`
+	var res IngestSimulatePipelineResponse
+	if err := json.Unmarshal([]byte(body), &res); err != nil {
+		t.Fatal(err)
+	}
+	if want, have := 1, len(res.Docs); want != have {
+		t.Fatalf("expected %d docs; got: %d", want, have)
+	}
+	steps := res.Docs[0].ProcessorResults
+	if want, have := 2, len(steps); want != have {
+		t.Fatalf("expected %d processor results; got: %d", want, have)
+	}
+	if want, have := "processor[set]-1", steps[1].ProcessorTag; want != have {
+		t.Errorf("expected tag %q; got: %q", want, have)
+	}
+	source, ok := steps[1].Doc["_source"].(map[string]interface{})
+	if !ok {
+		t.Fatalf("expected doc to have a _source; got: %v", steps[1].Doc)
+	}
+	if want, have := "_value3", source["field3"]; want != have {
+		t.Errorf("expected field3 = %v; got: %v", want, have)
+	}
+}
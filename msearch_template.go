@@ -0,0 +1,294 @@
+// Copyright 2012-present Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"golang.org/x/net/context"
+)
+
+// MultiSearchTemplateService executes one or more search templates
+// in one roundtrip.
+// See https://www.elastic.co/guide/en/elasticsearch/reference/current/multi-search-template.html
+type MultiSearchTemplateService struct {
+	client     *Client
+	requests   []*SearchTemplateRequest
+	indices    []string
+	pretty     bool
+	searchType string
+}
+
+// NewMultiSearchTemplateService creates a new MultiSearchTemplateService.
+func NewMultiSearchTemplateService(client *Client) *MultiSearchTemplateService {
+	return &MultiSearchTemplateService{
+		client:   client,
+		requests: make([]*SearchTemplateRequest, 0),
+		indices:  make([]string, 0),
+	}
+}
+
+// Add adds one or more search template requests.
+func (s *MultiSearchTemplateService) Add(requests ...*SearchTemplateRequest) *MultiSearchTemplateService {
+	s.requests = append(s.requests, requests...)
+	return s
+}
+
+// Index sets the default indices for requests that do not specify
+// indices themselves.
+func (s *MultiSearchTemplateService) Index(indices ...string) *MultiSearchTemplateService {
+	s.indices = append(s.indices, indices...)
+	return s
+}
+
+// SearchType sets the default search operation type for all requests.
+func (s *MultiSearchTemplateService) SearchType(searchType string) *MultiSearchTemplateService {
+	s.searchType = searchType
+	return s
+}
+
+// Pretty indicates that the JSON response be indented and human readable.
+func (s *MultiSearchTemplateService) Pretty(pretty bool) *MultiSearchTemplateService {
+	s.pretty = pretty
+	return s
+}
+
+// buildURL builds the URL for the operation.
+func (s *MultiSearchTemplateService) buildURL() (string, url.Values, error) {
+	// Build URL
+	path := "/_msearch/template"
+
+	// Add query string parameters
+	params := url.Values{}
+	if s.pretty {
+		params.Set("pretty", "1")
+	}
+	if s.searchType != "" {
+		params.Set("search_type", s.searchType)
+	}
+	return path, params, nil
+}
+
+// Validate checks if the operation is valid.
+func (s *MultiSearchTemplateService) Validate() error {
+	var invalid []string
+	if len(s.requests) == 0 {
+		invalid = append(invalid, "Requests")
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf("missing required fields: %v", invalid)
+	}
+	for _, r := range s.requests {
+		if err := r.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// body returns the NDJSON body with one header and one body line
+// per request, including the trailing newline.
+func (s *MultiSearchTemplateService) body() (string, error) {
+	var lines []string
+	for _, r := range s.requests {
+		// Set default indices if not specified in the request
+		if !r.HasIndices() && len(s.indices) > 0 {
+			r = r.Index(s.indices...)
+		}
+
+		header, err := json.Marshal(r.header())
+		if err != nil {
+			return "", err
+		}
+		body, err := json.Marshal(r.body())
+		if err != nil {
+			return "", err
+		}
+		lines = append(lines, string(header))
+		lines = append(lines, string(body))
+	}
+	return strings.Join(lines, "\n") + "\n", nil // Don't forget trailing \n
+}
+
+// Do executes the operation.
+func (s *MultiSearchTemplateService) Do(ctx context.Context) (*MultiSearchResult, error) {
+	// Check pre-conditions
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+
+	// Get URL for request
+	path, params, err := s.buildURL()
+	if err != nil {
+		return nil, err
+	}
+
+	// Setup HTTP request body
+	body, err := s.body()
+	if err != nil {
+		return nil, err
+	}
+
+	// Get HTTP response
+	res, err := s.client.PerformRequest(ctx, "POST", path, params, body)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return operation response
+	ret := new(MultiSearchResult)
+	if err := s.client.decoder.Decode(res.Body, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// -- SearchTemplateRequest --
+
+// SearchTemplateRequest is a single search template request used in
+// combination with MultiSearchTemplateService. It either refers to a
+// stored template by id or specifies an inline template via source.
+type SearchTemplateRequest struct {
+	searchType string
+	indices    []string
+	types      []string
+	routing    string
+	preference string
+	id         string
+	source     interface{}
+	params     map[string]interface{}
+}
+
+// NewSearchTemplateRequest creates a new search template request.
+func NewSearchTemplateRequest() *SearchTemplateRequest {
+	return &SearchTemplateRequest{
+		indices: make([]string, 0),
+		types:   make([]string, 0),
+	}
+}
+
+// SearchType sets the search operation type for this request.
+func (r *SearchTemplateRequest) SearchType(searchType string) *SearchTemplateRequest {
+	r.searchType = searchType
+	return r
+}
+
+// Index sets the indices to search.
+func (r *SearchTemplateRequest) Index(indices ...string) *SearchTemplateRequest {
+	r.indices = append(r.indices, indices...)
+	return r
+}
+
+// HasIndices returns true if the request specifies any indices.
+func (r *SearchTemplateRequest) HasIndices() bool {
+	return len(r.indices) > 0
+}
+
+// Type sets the document types to search.
+func (r *SearchTemplateRequest) Type(types ...string) *SearchTemplateRequest {
+	r.types = append(r.types, types...)
+	return r
+}
+
+// Routing sets the routing value.
+func (r *SearchTemplateRequest) Routing(routing string) *SearchTemplateRequest {
+	r.routing = routing
+	return r
+}
+
+// Preference sets the shard preference.
+func (r *SearchTemplateRequest) Preference(preference string) *SearchTemplateRequest {
+	r.preference = preference
+	return r
+}
+
+// Id sets the id of a stored search template.
+func (r *SearchTemplateRequest) Id(id string) *SearchTemplateRequest {
+	r.id = id
+	return r
+}
+
+// Source sets an inline search template. It can be a string or
+// anything that serializes to a JSON object.
+func (r *SearchTemplateRequest) Source(source interface{}) *SearchTemplateRequest {
+	r.source = source
+	return r
+}
+
+// Params sets the parameters used to render the template.
+func (r *SearchTemplateRequest) Params(params map[string]interface{}) *SearchTemplateRequest {
+	r.params = params
+	return r
+}
+
+// Param sets a single parameter used to render the template.
+func (r *SearchTemplateRequest) Param(name string, value interface{}) *SearchTemplateRequest {
+	if r.params == nil {
+		r.params = make(map[string]interface{})
+	}
+	r.params[name] = value
+	return r
+}
+
+// Validate checks if the request is valid.
+func (r *SearchTemplateRequest) Validate() error {
+	if r.id == "" && r.source == nil {
+		return errors.New("elastic: either Id or Source is required in SearchTemplateRequest")
+	}
+	if r.id != "" && r.source != nil {
+		return errors.New("elastic: Id and Source are mutually exclusive in SearchTemplateRequest")
+	}
+	return nil
+}
+
+// header returns the header line of this request in a multi search.
+func (r *SearchTemplateRequest) header() interface{} {
+	h := make(map[string]interface{})
+	if r.searchType != "" {
+		h["search_type"] = r.searchType
+	}
+
+	switch len(r.indices) {
+	case 0:
+	case 1:
+		h["index"] = r.indices[0]
+	default:
+		h["index"] = r.indices
+	}
+
+	switch len(r.types) {
+	case 0:
+	case 1:
+		h["type"] = r.types[0]
+	default:
+		h["type"] = r.types
+	}
+
+	if r.routing != "" {
+		h["routing"] = r.routing
+	}
+	if r.preference != "" {
+		h["preference"] = r.preference
+	}
+	return h
+}
+
+// body returns the body line of this request in a multi search.
+func (r *SearchTemplateRequest) body() interface{} {
+	b := make(map[string]interface{})
+	if r.id != "" {
+		b["id"] = r.id
+	} else {
+		b["source"] = r.source
+	}
+	if len(r.params) > 0 {
+		b["params"] = r.params
+	}
+	return b
+}
@@ -0,0 +1,111 @@
+// Copyright 2012-present Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"golang.org/x/net/context"
+)
+
+func TestMultiSearchTemplateBody(t *testing.T) {
+	client := setupTestClient(t)
+
+	s := client.MultiSearchTemplate().
+		Index("twitter").
+		Add(
+			NewSearchTemplateRequest().Id("tmpl").Param("user", "olivere"),
+			NewSearchTemplateRequest().Index("blog").Type("post").
+				Source(map[string]interface{}{
+					"query": map[string]interface{}{
+						"match": map[string]interface{}{"{{field}}": "{{value}}"},
+					},
+				}).
+				Params(map[string]interface{}{"field": "title", "value": "golang"}),
+			NewSearchTemplateRequest().Id("no-params").Routing("r1").Preference("_local"),
+		)
+	if err := s.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	got, err := s.body()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := `{"index":"twitter"}
+{"id":"tmpl","params":{"user":"olivere"}}
+{"index":"blog","type":"post"}
+{"params":{"field":"title","value":"golang"},"source":{"query":{"match":{"{{field}}":"{{value}}"}}}}
+{"index":"twitter","preference":"_local","routing":"r1"}
+{"id":"no-params"}
+`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
+
+func TestMultiSearchTemplateValidate(t *testing.T) {
+	client := setupTestClient(t)
+
+	if err := client.MultiSearchTemplate().Validate(); err == nil {
+		t.Fatal("expected error when no requests are given")
+	}
+	if err := client.MultiSearchTemplate().Add(NewSearchTemplateRequest()).Validate(); err == nil {
+		t.Fatal("expected error when neither id nor source is given")
+	}
+	err := client.MultiSearchTemplate().Add(NewSearchTemplateRequest().Id("tmpl").Source(`{}`)).Validate()
+	if err == nil {
+		t.Fatal("expected error when both id and source are given")
+	}
+}
+
+func TestMultiSearchTemplateDo(t *testing.T) {
+	var path, body string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			t.Error(err)
+		}
+		path, body = r.URL.Path, string(data)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"responses":[{"took":1,"hits":{"total":1,"hits":[{"_index":"twitter","_type":"tweet","_id":"1"}]}},{"took":2,"hits":{"total":0,"hits":[]}}]}`)
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(SetURL(ts.URL), SetSniff(false), SetHealthcheck(false), SetMaxRetries(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := client.MultiSearchTemplate().
+		Add(NewSearchTemplateRequest().Index("twitter").Id("tmpl")).
+		Add(NewSearchTemplateRequest().Index("twitter").Source(`{"query":{"match_all":{}}}`)).
+		Do(context.TODO())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, have := "/_msearch/template", path; want != have {
+		t.Errorf("expected path %q; got: %q", want, have)
+	}
+	expected := `{"index":"twitter"}
+{"id":"tmpl"}
+{"index":"twitter"}
+{"source":"{\"query\":{\"match_all\":{}}}"}
+`
+	if body != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, body)
+	}
+	if want, have := 2, len(res.Responses); want != have {
+		t.Fatalf("expected %d responses; got: %d", want, have)
+	}
+	if want, have := int64(1), res.Responses[0].TotalHits(); want != have {
+		t.Errorf("expected %d hits; got: %d", want, have)
+	}
+	if want, have := int64(0), res.Responses[1].TotalHits(); want != have {
+		t.Errorf("expected %d hits; got: %d", want, have)
+	}
+}
@@ -422,8 +422,9 @@ func (s *MultiTermvectorItem) TermStatistics(termStatistics bool) *MultiTermvect
 func (s *MultiTermvectorItem) Source() interface{} {
 	source := make(map[string]interface{})
 
-	source["_id"] = s.id
-
+	if s.id != "" {
+		source["_id"] = s.id
+	}
 	if s.index != "" {
 		source["_index"] = s.index
 	}
@@ -5,6 +5,11 @@
 package elastic
 
 import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"golang.org/x/net/context"
@@ -133,3 +138,96 @@ func TestMultiTermVectorsWithIds(t *testing.T) {
 		t.Fatalf("expected to have 2 docs; got %d", len(res.Docs))
 	}
 }
+
+func TestMultiTermVectorsSource(t *testing.T) {
+	client := setupTestClient(t)
+
+	builder := client.MultiTermVectors().
+		Index("twitter").
+		Add(NewMultiTermvectorItem().Id("1").Fields("message")).
+		Add(NewMultiTermvectorItem().Doc(map[string]interface{}{"message": "test test"}))
+	data, err := json.Marshal(builder.Source())
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := string(data)
+	expected := `{"docs":[{"_id":"1","fields":["message"]},{"doc":{"message":"test test"}}]}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
+
+func TestMultiTermVectorsDecode(t *testing.T) {
+	var path, body string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			t.Error(err)
+		}
+		path, body = r.URL.Path, string(data)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{
+			"docs": [
+				{
+					"_index": "twitter",
+					"_type": "tweet",
+					"_id": "1",
+					"_version": 1,
+					"found": true,
+					"took": 1,
+					"term_vectors": {
+						"message": {
+							"field_statistics": {"sum_doc_freq": 6, "doc_count": 2, "sum_ttf": 8},
+							"terms": {"test": {"doc_freq": 2, "ttf": 4, "term_freq": 3}}
+						}
+					}
+				},
+				{
+					"_index": "twitter",
+					"_type": "tweet",
+					"_version": 0,
+					"found": true,
+					"took": 1,
+					"term_vectors": {
+						"message": {
+							"field_statistics": {"sum_doc_freq": 6, "doc_count": 2, "sum_ttf": 8},
+							"terms": {"test": {"doc_freq": 2, "ttf": 4, "term_freq": 2}}
+						}
+					}
+				}
+			]
+		}`)
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(SetURL(ts.URL), SetSniff(false), SetHealthcheck(false), SetMaxRetries(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := client.MultiTermVectors().
+		Index("twitter").
+		Add(NewMultiTermvectorItem().Id("1")).
+		Add(NewMultiTermvectorItem().Doc(map[string]interface{}{"message": "test test"})).
+		Do(context.TODO())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, have := "/twitter/_mtermvectors", path; want != have {
+		t.Errorf("expected path %q; got: %q", want, have)
+	}
+	if want, have := `{"docs":[{"_id":"1"},{"doc":{"message":"test test"}}]}`, body; want != have {
+		t.Errorf("expected body %s; got: %s", want, have)
+	}
+	if want, have := 2, len(res.Docs); want != have {
+		t.Fatalf("expected %d docs; got: %d", want, have)
+	}
+	if want, have := int64(3), res.Docs[0].TermVectors["message"].Terms["test"].TermFreq; want != have {
+		t.Errorf("expected term_freq %d; got: %d", want, have)
+	}
+	if want, have := int64(2), res.Docs[1].TermVectors["message"].Terms["test"].TermFreq; want != have {
+		t.Errorf("expected term_freq %d; got: %d", want, have)
+	}
+	if want, have := int64(2), res.Docs[1].TermVectors["message"].FieldStatistics.DocCount; want != have {
+		t.Errorf("expected doc_count %d; got: %d", want, have)
+	}
+}
@@ -31,8 +31,6 @@ type NodesInfoService struct {
 func NewNodesInfoService(client *Client) *NodesInfoService {
 	return &NodesInfoService{
 		client: client,
-		nodeId: []string{"_all"},
-		metric: []string{"_all"},
 	}
 }
 
@@ -72,10 +70,18 @@ func (s *NodesInfoService) Pretty(pretty bool) *NodesInfoService {
 
 // buildURL builds the URL for the operation.
 func (s *NodesInfoService) buildURL() (string, url.Values, error) {
+	nodeId, metric := "_all", "_all"
+	if len(s.nodeId) > 0 {
+		nodeId = strings.Join(s.nodeId, ",")
+	}
+	if len(s.metric) > 0 {
+		metric = strings.Join(s.metric, ",")
+	}
+
 	// Build URL
 	path, err := uritemplates.Expand("/_nodes/{node_id}/{metric}", map[string]string{
-		"node_id": strings.Join(s.nodeId, ","),
-		"metric":  strings.Join(s.metric, ","),
+		"node_id": nodeId,
+		"metric":  metric,
 	})
 	if err != nil {
 		return "", url.Values{}, err
@@ -150,6 +156,8 @@ type NodesInfoNode struct {
 	HTTPAddress string `json:"http_address"`
 	// HTTPSAddress, e.g. "127.0.0.1:9200"
 	HTTPSAddress string `json:"https_address"`
+	// Roles is a list of the roles of the node, e.g. master, data, ingest.
+	Roles []string `json:"roles"`
 
 	// Attributes of the node.
 	Attributes map[string]interface{} `json:"attributes"`
@@ -164,7 +172,7 @@ type NodesInfoNode struct {
 	Process *NodesInfoNodeProcess `json:"process"`
 
 	// JVM information, e.g. VM version.
-	JVM *NodesInfoNodeProcess `json:"jvm"`
+	JVM *NodesInfoNodeJVM `json:"jvm"`
 
 	// ThreadPool information.
 	ThreadPool *NodesInfoNodeThreadPool `json:"thread_pool"`
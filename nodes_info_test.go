@@ -5,6 +5,7 @@
 package elastic
 
 import (
+	"encoding/json"
 	"testing"
 
 	"golang.org/x/net/context"
@@ -42,3 +43,125 @@ func TestNodesInfo(t *testing.T) {
 		}
 	}
 }
+
+func TestNodesInfoBuildURL(t *testing.T) {
+	tests := []struct {
+		NodeIds  []string
+		Metrics  []string
+		Expected string
+	}{
+		{
+			NodeIds:  nil,
+			Metrics:  nil,
+			Expected: "/_nodes/_all/_all",
+		},
+		{
+			NodeIds:  []string{"node1"},
+			Metrics:  []string{"plugins"},
+			Expected: "/_nodes/node1/plugins",
+		},
+		{
+			NodeIds:  []string{"node1", "node2"},
+			Metrics:  []string{"settings", "os", "jvm"},
+			Expected: "/_nodes/node1%2Cnode2/settings%2Cos%2Cjvm",
+		},
+	}
+
+	client := setupTestClient(t)
+	for i, tt := range tests {
+		svc := client.NodesInfo().NodeId(tt.NodeIds...).Metric(tt.Metrics...)
+		path, _, err := svc.buildURL()
+		if err != nil {
+			t.Errorf("#%d: expected no error, got %v", i, err)
+		} else {
+			if want, have := tt.Expected, path; want != have {
+				t.Errorf("#%d: expected %q, got %q", i, want, have)
+			}
+		}
+	}
+}
+
+func TestNodesInfoResponseDecode(t *testing.T) {
+	body := `{
+		"cluster_name": "elasticsearch",
+		"nodes": {
+			"node1": {
+				"name": "master-1",
+				"version": "5.1.1",
+				"roles": ["master"],
+				"os": { "available_processors": 2 },
+				"plugins": []
+			},
+			"node2": {
+				"name": "data-1",
+				"version": "5.1.1",
+				"roles": ["data", "ingest"],
+				"os": { "available_processors": 8 },
+				"jvm": { "pid": 4711, "version": "1.8.0_111" },
+				"plugins": [
+					{ "name": "analysis-icu", "version": "5.1.1" },
+					{ "name": "ingest-geoip", "version": "5.1.1" }
+				]
+			}
+		}
+	}`
+	var res NodesInfoResponse
+	if err := json.Unmarshal([]byte(body), &res); err != nil {
+		t.Fatal(err)
+	}
+	if want, have := 2, len(res.Nodes); want != have {
+		t.Fatalf("expected %d nodes; got: %d", want, have)
+	}
+
+	master := res.Nodes["node1"]
+	if master == nil {
+		t.Fatalf("expected node %q; got: %v", "node1", res.Nodes)
+	}
+	if want, have := "5.1.1", master.Version; want != have {
+		t.Errorf("expected version = %q; got: %q", want, have)
+	}
+	if want, have := 1, len(master.Roles); want != have {
+		t.Fatalf("expected %d roles; got: %d", want, have)
+	}
+	if want, have := "master", master.Roles[0]; want != have {
+		t.Errorf("expected role = %q; got: %q", want, have)
+	}
+	if master.OS == nil {
+		t.Fatal("expected os info; got: nil")
+	}
+	if want, have := 2, master.OS.AvailableProcessors; want != have {
+		t.Errorf("expected available_processors = %d; got: %d", want, have)
+	}
+
+	data := res.Nodes["node2"]
+	if data == nil {
+		t.Fatalf("expected node %q; got: %v", "node2", res.Nodes)
+	}
+	if want, have := 2, len(data.Roles); want != have {
+		t.Fatalf("expected %d roles; got: %d", want, have)
+	}
+	if want, have := "data", data.Roles[0]; want != have {
+		t.Errorf("expected role = %q; got: %q", want, have)
+	}
+	if want, have := "ingest", data.Roles[1]; want != have {
+		t.Errorf("expected role = %q; got: %q", want, have)
+	}
+	if data.OS == nil {
+		t.Fatal("expected os info; got: nil")
+	}
+	if want, have := 8, data.OS.AvailableProcessors; want != have {
+		t.Errorf("expected available_processors = %d; got: %d", want, have)
+	}
+	if data.JVM == nil {
+		t.Fatal("expected jvm info; got: nil")
+	}
+	if want, have := "1.8.0_111", data.JVM.Version; want != have {
+		t.Errorf("expected jvm version = %q; got: %q", want, have)
+	}
+	if want, have := 2, len(data.Plugins); want != have {
+		t.Fatalf("expected %d plugins; got: %d", want, have)
+	}
+	if want, have := "analysis-icu", data.Plugins[0].Name; want != have {
+		t.Errorf("expected plugin name = %q; got: %q", want, have)
+	}
+}
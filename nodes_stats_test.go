@@ -5,6 +5,7 @@
 package elastic
 
 import (
+	"encoding/json"
 	"testing"
 
 	"golang.org/x/net/context"
@@ -113,6 +114,12 @@ func TestNodesStatsBuildURL(t *testing.T) {
 			IndexMetrics: []string{"fielddata"},
 			Expected:     "/_nodes/node1/stats/indices/fielddata",
 		},
+		{
+			NodeIds:      nil,
+			Metrics:      []string{"jvm", "os", "process", "thread_pool", "fs"},
+			IndexMetrics: nil,
+			Expected:     "/_nodes/stats/jvm%2Cos%2Cprocess%2Cthread_pool%2Cfs",
+		},
 		{
 			NodeIds:      []string{"node1", "node2"},
 			Metrics:      []string{"indices", "jvm"},
@@ -137,3 +144,80 @@ func TestNodesStatsBuildURL(t *testing.T) {
 		}
 	}
 }
+
+func TestNodesStatsResponseDecode(t *testing.T) {
+	body := `{
+		"cluster_name": "elasticsearch",
+		"nodes": {
+			"8CsxYsMgRCqsYK9BBXqSkQ": {
+				"timestamp": 1483621262147,
+				"name": "node-1",
+				"transport_address": "127.0.0.1:9300",
+				"host": "127.0.0.1",
+				"ip": "127.0.0.1:9300",
+				"roles": ["master", "data", "ingest"],
+				"jvm": {
+					"timestamp": 1483621262147,
+					"uptime_in_millis": 1234567,
+					"mem": {
+						"heap_used_in_bytes": 268435456,
+						"heap_used_percent": 25,
+						"heap_committed_in_bytes": 1073741824,
+						"heap_max_in_bytes": 1073741824
+					}
+				},
+				"thread_pool": {
+					"search": {
+						"threads": 7,
+						"queue": 3,
+						"active": 2,
+						"rejected": 11,
+						"largest": 7,
+						"completed": 4711
+					},
+					"bulk": {
+						"threads": 4,
+						"queue": 0,
+						"active": 0,
+						"rejected": 0,
+						"largest": 4,
+						"completed": 42
+					}
+				}
+			}
+		}
+	}`
+	var res NodesStatsResponse
+	if err := json.Unmarshal([]byte(body), &res); err != nil {
+		t.Fatal(err)
+	}
+	if want, have := 1, len(res.Nodes); want != have {
+		t.Fatalf("expected %d nodes; got: %d", want, have)
+	}
+	node, found := res.Nodes["8CsxYsMgRCqsYK9BBXqSkQ"]
+	if !found || node == nil {
+		t.Fatalf("expected node %q; got: %v", "8CsxYsMgRCqsYK9BBXqSkQ", res.Nodes)
+	}
+	if want, have := "node-1", node.Name; want != have {
+		t.Errorf("expected name = %q; got: %q", want, have)
+	}
+	if node.JVM == nil || node.JVM.Mem == nil {
+		t.Fatal("expected jvm.mem stats; got: nil")
+	}
+	if want, have := int64(268435456), node.JVM.Mem.HeapUsedInBytes; want != have {
+		t.Errorf("expected heap_used_in_bytes = %d; got: %d", want, have)
+	}
+	if want, have := 25, node.JVM.Mem.HeapUsedPercent; want != have {
+		t.Errorf("expected heap_used_percent = %d; got: %d", want, have)
+	}
+	search, found := node.ThreadPool["search"]
+	if !found || search == nil {
+		t.Fatalf("expected thread pool %q; got: %v", "search", node.ThreadPool)
+	}
+	if want, have := 3, search.Queue; want != have {
+		t.Errorf("expected search queue = %d; got: %d", want, have)
+	}
+	if want, have := int64(11), search.Rejected; want != have {
+		t.Errorf("expected search rejected = %d; got: %d", want, have)
+	}
+}
@@ -0,0 +1,193 @@
+// Copyright 2012-present Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+import (
+	"fmt"
+	"net/url"
+
+	"golang.org/x/net/context"
+
+	"gopkg.in/olivere/elastic.v5/uritemplates"
+)
+
+// PutScriptService adds or updates a stored script in Elasticsearch.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/6.2/modules-scripting-using.html#modules-scripting-stored-scripts
+// for details.
+type PutScriptService struct {
+	client        *Client
+	pretty        bool
+	id            string
+	context       string
+	masterTimeout string
+	timeout       string
+	lang          string
+	source        string
+	bodyJson      interface{}
+	bodyString    string
+}
+
+// NewPutScriptService creates a new PutScriptService.
+func NewPutScriptService(client *Client) *PutScriptService {
+	return &PutScriptService{
+		client: client,
+	}
+}
+
+// Id is the script ID.
+func (s *PutScriptService) Id(id string) *PutScriptService {
+	s.id = id
+	return s
+}
+
+// Context specifies the script context (e.g. "score"). It is optional.
+// If set, Elasticsearch compiles the script against that context.
+func (s *PutScriptService) Context(context string) *PutScriptService {
+	s.context = context
+	return s
+}
+
+// MasterTimeout is an explicit operation timeout for connection to master node.
+func (s *PutScriptService) MasterTimeout(masterTimeout string) *PutScriptService {
+	s.masterTimeout = masterTimeout
+	return s
+}
+
+// Timeout is an explicit operation timeout.
+func (s *PutScriptService) Timeout(timeout string) *PutScriptService {
+	s.timeout = timeout
+	return s
+}
+
+// Script sets the language (e.g. "painless") and the source of the
+// stored script.
+func (s *PutScriptService) Script(lang, source string) *PutScriptService {
+	s.lang = lang
+	s.source = source
+	return s
+}
+
+// Pretty indicates that the JSON response be indented and human readable.
+func (s *PutScriptService) Pretty(pretty bool) *PutScriptService {
+	s.pretty = pretty
+	return s
+}
+
+// BodyJson is the document as a JSON serializable object.
+// It overrides Script.
+func (s *PutScriptService) BodyJson(body interface{}) *PutScriptService {
+	s.bodyJson = body
+	return s
+}
+
+// BodyString is the document as a string.
+// It overrides Script.
+func (s *PutScriptService) BodyString(body string) *PutScriptService {
+	s.bodyString = body
+	return s
+}
+
+// buildURL builds the URL for the operation.
+func (s *PutScriptService) buildURL() (string, url.Values, error) {
+	var err error
+	var path string
+
+	// Build URL
+	if s.context != "" {
+		path, err = uritemplates.Expand("/_scripts/{id}/{context}", map[string]string{
+			"id":      s.id,
+			"context": s.context,
+		})
+	} else {
+		path, err = uritemplates.Expand("/_scripts/{id}", map[string]string{
+			"id": s.id,
+		})
+	}
+	if err != nil {
+		return "", url.Values{}, err
+	}
+
+	// Add query string parameters
+	params := url.Values{}
+	if s.pretty {
+		params.Set("pretty", "1")
+	}
+	if s.masterTimeout != "" {
+		params.Set("master_timeout", s.masterTimeout)
+	}
+	if s.timeout != "" {
+		params.Set("timeout", s.timeout)
+	}
+	return path, params, nil
+}
+
+// Validate checks if the operation is valid.
+func (s *PutScriptService) Validate() error {
+	var invalid []string
+	if s.id == "" {
+		invalid = append(invalid, "Id")
+	}
+	if s.bodyString == "" && s.bodyJson == nil && s.source == "" {
+		invalid = append(invalid, "BodyJson")
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf("missing required fields: %v", invalid)
+	}
+	return nil
+}
+
+// getBody returns the body of the request.
+func (s *PutScriptService) getBody() interface{} {
+	if s.bodyJson != nil {
+		return s.bodyJson
+	}
+	if s.bodyString != "" {
+		return s.bodyString
+	}
+	// {"script":{"lang":"painless","source":"..."}}
+	script := map[string]interface{}{
+		"source": s.source,
+	}
+	if s.lang != "" {
+		script["lang"] = s.lang
+	}
+	return map[string]interface{}{
+		"script": script,
+	}
+}
+
+// Do executes the operation.
+func (s *PutScriptService) Do(ctx context.Context) (*PutScriptResponse, error) {
+	// Check pre-conditions
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+
+	// Get URL for request
+	path, params, err := s.buildURL()
+	if err != nil {
+		return nil, err
+	}
+
+	// Get HTTP response
+	res, err := s.client.PerformRequest(ctx, "PUT", path, params, s.getBody())
+	if err != nil {
+		return nil, err
+	}
+
+	// Return operation response
+	ret := new(PutScriptResponse)
+	if err := s.client.decoder.Decode(res.Body, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// PutScriptResponse is the result of saving a stored script
+// in Elasticsearch.
+type PutScriptResponse struct {
+	AcknowledgedResponse
+}
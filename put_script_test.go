@@ -0,0 +1,64 @@
+// Copyright 2012-present Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestPutScriptURL(t *testing.T) {
+	client := setupTestClient(t)
+
+	tests := []struct {
+		Id       string
+		Context  string
+		Expected string
+	}{
+		{
+			"my-script",
+			"",
+			"/_scripts/my-script",
+		},
+		{
+			"my-script",
+			"score",
+			"/_scripts/my-script/score",
+		},
+	}
+
+	for i, test := range tests {
+		path, _, err := client.PutScript().Id(test.Id).Context(test.Context).buildURL()
+		if err != nil {
+			t.Errorf("case #%d: %v", i+1, err)
+			continue
+		}
+		if path != test.Expected {
+			t.Errorf("case #%d: expected %q; got: %q", i+1, test.Expected, path)
+		}
+	}
+}
+
+func TestPutScriptBody(t *testing.T) {
+	client := setupTestClient(t)
+
+	svc := client.PutScript().Id("my-script").Script("painless", "Math.log(_score * 2) + params.my_modifier")
+	if err := svc.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(svc.getBody())
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"script":{"lang":"painless","source":"Math.log(_score * 2) + params.my_modifier"}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+
+	if err := client.PutScript().Id("my-script").Validate(); err == nil {
+		t.Error("expected Validate to fail without a script")
+	}
+}
@@ -0,0 +1,350 @@
+// Copyright 2012-present Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"golang.org/x/net/context"
+
+	"gopkg.in/olivere/elastic.v5/uritemplates"
+)
+
+// RankEvalService evaluates the quality of ranked search results over
+// a set of typical search queries, given a list of rated documents for
+// each query.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/current/search-rank-eval.html
+// for details.
+type RankEvalService struct {
+	client            *Client
+	pretty            bool
+	index             []string
+	spec              *RankEvalSpec
+	ignoreUnavailable *bool
+	allowNoIndices    *bool
+	expandWildcards   string
+	searchType        string
+}
+
+// NewRankEvalService creates a new RankEvalService.
+func NewRankEvalService(client *Client) *RankEvalService {
+	return &RankEvalService{
+		client: client,
+	}
+}
+
+// Index is a list of index names to evaluate the requests against.
+func (s *RankEvalService) Index(indices ...string) *RankEvalService {
+	s.index = append(s.index, indices...)
+	return s
+}
+
+// Spec sets the requests, ratings, and metric of the evaluation.
+func (s *RankEvalService) Spec(spec *RankEvalSpec) *RankEvalService {
+	s.spec = spec
+	return s
+}
+
+// IgnoreUnavailable indicates whether the specified concrete indices
+// should be ignored when unavailable (missing or closed).
+func (s *RankEvalService) IgnoreUnavailable(ignoreUnavailable bool) *RankEvalService {
+	s.ignoreUnavailable = &ignoreUnavailable
+	return s
+}
+
+// AllowNoIndices indicates whether to ignore if a wildcard indices
+// expression resolves into no concrete indices. (This includes `_all`
+// string or when no indices have been specified).
+func (s *RankEvalService) AllowNoIndices(allowNoIndices bool) *RankEvalService {
+	s.allowNoIndices = &allowNoIndices
+	return s
+}
+
+// ExpandWildcards indicates whether to expand wildcard expression to
+// concrete indices that are open, closed or both.
+func (s *RankEvalService) ExpandWildcards(expandWildcards string) *RankEvalService {
+	s.expandWildcards = expandWildcards
+	return s
+}
+
+// SearchType is the search operation type, e.g. "query_then_fetch"
+// or "dfs_query_then_fetch".
+func (s *RankEvalService) SearchType(searchType string) *RankEvalService {
+	s.searchType = searchType
+	return s
+}
+
+// Pretty indicates that the JSON response be indented and human readable.
+func (s *RankEvalService) Pretty(pretty bool) *RankEvalService {
+	s.pretty = pretty
+	return s
+}
+
+// buildURL builds the URL for the operation.
+func (s *RankEvalService) buildURL() (string, url.Values, error) {
+	var err error
+	var path string
+	// Build URL
+	if len(s.index) > 0 {
+		path, err = uritemplates.Expand("/{index}/_rank_eval", map[string]string{
+			"index": strings.Join(s.index, ","),
+		})
+	} else {
+		path = "/_rank_eval"
+	}
+	if err != nil {
+		return "", url.Values{}, err
+	}
+
+	// Add query string parameters
+	params := url.Values{}
+	if s.pretty {
+		params.Set("pretty", "1")
+	}
+	if s.ignoreUnavailable != nil {
+		params.Set("ignore_unavailable", fmt.Sprintf("%v", *s.ignoreUnavailable))
+	}
+	if s.allowNoIndices != nil {
+		params.Set("allow_no_indices", fmt.Sprintf("%v", *s.allowNoIndices))
+	}
+	if s.expandWildcards != "" {
+		params.Set("expand_wildcards", s.expandWildcards)
+	}
+	if s.searchType != "" {
+		params.Set("search_type", s.searchType)
+	}
+	return path, params, nil
+}
+
+// Validate checks if the operation is valid.
+func (s *RankEvalService) Validate() error {
+	var invalid []string
+	if s.spec == nil {
+		invalid = append(invalid, "Spec")
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf("missing required fields: %v", invalid)
+	}
+	return s.spec.Validate()
+}
+
+// Do executes the operation.
+func (s *RankEvalService) Do(ctx context.Context) (*RankEvalResponse, error) {
+	// Check pre-conditions
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+
+	// Get URL for request
+	path, params, err := s.buildURL()
+	if err != nil {
+		return nil, err
+	}
+
+	// Setup HTTP request body
+	body, err := s.spec.Source()
+	if err != nil {
+		return nil, err
+	}
+
+	// Get HTTP response
+	res, err := s.client.PerformRequest(ctx, "POST", path, params, body)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return operation response
+	ret := new(RankEvalResponse)
+	if err := s.client.decoder.Decode(res.Body, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// -- RankEvalSpec --
+
+// RankEvalSpec is the body of a RankEvalService request. It consists of
+// the search requests to evaluate, the rated documents for each of them,
+// and the metric to compute.
+type RankEvalSpec struct {
+	requests              []*RankEvalRequestItem
+	metric                RankEvalMetric
+	maxConcurrentSearches *int
+}
+
+// NewRankEvalSpec creates a new RankEvalSpec.
+func NewRankEvalSpec() *RankEvalSpec {
+	return &RankEvalSpec{}
+}
+
+// Requests adds one or more rated search requests.
+func (s *RankEvalSpec) Requests(requests ...*RankEvalRequestItem) *RankEvalSpec {
+	s.requests = append(s.requests, requests...)
+	return s
+}
+
+// Metric sets the evaluation metric, e.g. NewRankEvalPrecision().
+func (s *RankEvalSpec) Metric(metric RankEvalMetric) *RankEvalSpec {
+	s.metric = metric
+	return s
+}
+
+// MaxConcurrentSearches sets the maximum number of search requests
+// that are executed in parallel.
+func (s *RankEvalSpec) MaxConcurrentSearches(max int) *RankEvalSpec {
+	s.maxConcurrentSearches = &max
+	return s
+}
+
+// Validate checks if the spec is valid.
+func (s *RankEvalSpec) Validate() error {
+	var invalid []string
+	if len(s.requests) == 0 {
+		invalid = append(invalid, "Requests")
+	}
+	if s.metric == nil {
+		invalid = append(invalid, "Metric")
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf("missing required fields: %v", invalid)
+	}
+	for _, r := range s.requests {
+		if err := r.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Source returns the JSON-serializable body of the request.
+func (s *RankEvalSpec) Source() (interface{}, error) {
+	source := make(map[string]interface{})
+
+	var requests []interface{}
+	for _, r := range s.requests {
+		src, err := r.Source()
+		if err != nil {
+			return nil, err
+		}
+		requests = append(requests, src)
+	}
+	source["requests"] = requests
+
+	if s.metric != nil {
+		src, err := s.metric.Source()
+		if err != nil {
+			return nil, err
+		}
+		source["metric"] = src
+	}
+	if s.maxConcurrentSearches != nil {
+		source["max_concurrent_searches"] = *s.maxConcurrentSearches
+	}
+	return source, nil
+}
+
+// -- RankEvalRequestItem --
+
+// RankEvalRequestItem is a single search request of a RankEvalSpec,
+// together with the ratings of the documents it is expected to return.
+type RankEvalRequestItem struct {
+	id      string
+	request *SearchSource
+	ratings []RankEvalRating
+}
+
+// NewRankEvalRequestItem creates a new RankEvalRequestItem with the
+// given id. The id is used to report the results of this request in
+// RankEvalResponse.Details.
+func NewRankEvalRequestItem(id string) *RankEvalRequestItem {
+	return &RankEvalRequestItem{
+		id: id,
+	}
+}
+
+// Request sets the search request to evaluate.
+func (r *RankEvalRequestItem) Request(request *SearchSource) *RankEvalRequestItem {
+	r.request = request
+	return r
+}
+
+// Ratings adds one or more rated documents.
+func (r *RankEvalRequestItem) Ratings(ratings ...RankEvalRating) *RankEvalRequestItem {
+	r.ratings = append(r.ratings, ratings...)
+	return r
+}
+
+// Validate checks if the request item is valid.
+func (r *RankEvalRequestItem) Validate() error {
+	if r.id == "" {
+		return errors.New("elastic: id is required in RankEvalRequestItem")
+	}
+	if r.request == nil {
+		return fmt.Errorf("elastic: request is required in RankEvalRequestItem %q", r.id)
+	}
+	return nil
+}
+
+// Source returns the JSON-serializable body of the request item.
+func (r *RankEvalRequestItem) Source() (interface{}, error) {
+	source := make(map[string]interface{})
+	source["id"] = r.id
+	if r.request != nil {
+		src, err := r.request.Source()
+		if err != nil {
+			return nil, err
+		}
+		source["request"] = src
+	}
+	ratings := r.ratings
+	if ratings == nil {
+		ratings = []RankEvalRating{}
+	}
+	source["ratings"] = ratings
+	return source, nil
+}
+
+// RankEvalRating is the rating of a single document for a
+// RankEvalRequestItem.
+type RankEvalRating struct {
+	Index  string `json:"_index"`
+	Id     string `json:"_id"`
+	Rating int    `json:"rating"`
+}
+
+// -- Response --
+
+// RankEvalResponse is the response of RankEvalService.Do.
+type RankEvalResponse struct {
+	MetricScore float64                          `json:"metric_score"`
+	Details     map[string]*RankEvalQueryQuality `json:"details"`
+	Failures    map[string]interface{}           `json:"failures"`
+}
+
+// RankEvalQueryQuality is the outcome of evaluating a single
+// RankEvalRequestItem.
+type RankEvalQueryQuality struct {
+	MetricScore   float64                `json:"metric_score"`
+	UnratedDocs   []RankEvalUnratedDoc   `json:"unrated_docs"`
+	Hits          []RankEvalRatedHit     `json:"hits"`
+	MetricDetails map[string]interface{} `json:"metric_details"`
+}
+
+// RankEvalUnratedDoc is a document returned by a search request that
+// has no rating.
+type RankEvalUnratedDoc struct {
+	Index string `json:"_index"`
+	Id    string `json:"_id"`
+}
+
+// RankEvalRatedHit is a search hit together with its rating, if any.
+type RankEvalRatedHit struct {
+	Hit    *SearchHit `json:"hit"`
+	Rating *int       `json:"rating"`
+}
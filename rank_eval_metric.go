@@ -0,0 +1,225 @@
+// Copyright 2012-present Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+// RankEvalMetric is the metric used in a RankEvalSpec to evaluate
+// the search requests.
+type RankEvalMetric interface {
+	Source() (interface{}, error)
+}
+
+// -- Precision --
+
+// RankEvalPrecision computes the fraction of relevant documents in the
+// top k search results (precision@k).
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/current/search-rank-eval.html#k-precision
+// for details.
+type RankEvalPrecision struct {
+	k                       *int
+	relevantRatingThreshold *int
+	ignoreUnlabeled         *bool
+}
+
+// NewRankEvalPrecision creates a new RankEvalPrecision metric.
+func NewRankEvalPrecision() *RankEvalPrecision {
+	return &RankEvalPrecision{}
+}
+
+// K sets the number of top hits to evaluate.
+func (m *RankEvalPrecision) K(k int) *RankEvalPrecision {
+	m.k = &k
+	return m
+}
+
+// RelevantRatingThreshold sets the rating from which on a document is
+// considered to be relevant.
+func (m *RankEvalPrecision) RelevantRatingThreshold(threshold int) *RankEvalPrecision {
+	m.relevantRatingThreshold = &threshold
+	return m
+}
+
+// IgnoreUnlabeled indicates whether unrated documents should be ignored
+// instead of being counted as irrelevant.
+func (m *RankEvalPrecision) IgnoreUnlabeled(ignoreUnlabeled bool) *RankEvalPrecision {
+	m.ignoreUnlabeled = &ignoreUnlabeled
+	return m
+}
+
+// Source returns the JSON-serializable metric.
+func (m *RankEvalPrecision) Source() (interface{}, error) {
+	params := make(map[string]interface{})
+	if m.k != nil {
+		params["k"] = *m.k
+	}
+	if m.relevantRatingThreshold != nil {
+		params["relevant_rating_threshold"] = *m.relevantRatingThreshold
+	}
+	if m.ignoreUnlabeled != nil {
+		params["ignore_unlabeled"] = *m.ignoreUnlabeled
+	}
+	return map[string]interface{}{"precision": params}, nil
+}
+
+// -- Recall --
+
+// RankEvalRecall computes the fraction of all relevant documents that
+// are returned in the top k search results (recall@k).
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/current/search-rank-eval.html#k-recall
+// for details.
+type RankEvalRecall struct {
+	k                       *int
+	relevantRatingThreshold *int
+}
+
+// NewRankEvalRecall creates a new RankEvalRecall metric.
+func NewRankEvalRecall() *RankEvalRecall {
+	return &RankEvalRecall{}
+}
+
+// K sets the number of top hits to evaluate.
+func (m *RankEvalRecall) K(k int) *RankEvalRecall {
+	m.k = &k
+	return m
+}
+
+// RelevantRatingThreshold sets the rating from which on a document is
+// considered to be relevant.
+func (m *RankEvalRecall) RelevantRatingThreshold(threshold int) *RankEvalRecall {
+	m.relevantRatingThreshold = &threshold
+	return m
+}
+
+// Source returns the JSON-serializable metric.
+func (m *RankEvalRecall) Source() (interface{}, error) {
+	params := make(map[string]interface{})
+	if m.k != nil {
+		params["k"] = *m.k
+	}
+	if m.relevantRatingThreshold != nil {
+		params["relevant_rating_threshold"] = *m.relevantRatingThreshold
+	}
+	return map[string]interface{}{"recall": params}, nil
+}
+
+// -- Mean reciprocal rank --
+
+// RankEvalMeanReciprocalRank computes the reciprocal of the rank of the
+// first relevant document in the top k search results.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/current/search-rank-eval.html#_mean_reciprocal_rank
+// for details.
+type RankEvalMeanReciprocalRank struct {
+	k                       *int
+	relevantRatingThreshold *int
+}
+
+// NewRankEvalMeanReciprocalRank creates a new RankEvalMeanReciprocalRank metric.
+func NewRankEvalMeanReciprocalRank() *RankEvalMeanReciprocalRank {
+	return &RankEvalMeanReciprocalRank{}
+}
+
+// K sets the number of top hits to evaluate.
+func (m *RankEvalMeanReciprocalRank) K(k int) *RankEvalMeanReciprocalRank {
+	m.k = &k
+	return m
+}
+
+// RelevantRatingThreshold sets the rating from which on a document is
+// considered to be relevant.
+func (m *RankEvalMeanReciprocalRank) RelevantRatingThreshold(threshold int) *RankEvalMeanReciprocalRank {
+	m.relevantRatingThreshold = &threshold
+	return m
+}
+
+// Source returns the JSON-serializable metric.
+func (m *RankEvalMeanReciprocalRank) Source() (interface{}, error) {
+	params := make(map[string]interface{})
+	if m.k != nil {
+		params["k"] = *m.k
+	}
+	if m.relevantRatingThreshold != nil {
+		params["relevant_rating_threshold"] = *m.relevantRatingThreshold
+	}
+	return map[string]interface{}{"mean_reciprocal_rank": params}, nil
+}
+
+// -- Discounted cumulative gain --
+
+// RankEvalDCG computes the discounted cumulative gain of the top k
+// search results.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/current/search-rank-eval.html#_discounted_cumulative_gain_dcg
+// for details.
+type RankEvalDCG struct {
+	k         *int
+	normalize *bool
+}
+
+// NewRankEvalDCG creates a new RankEvalDCG metric.
+func NewRankEvalDCG() *RankEvalDCG {
+	return &RankEvalDCG{}
+}
+
+// K sets the number of top hits to evaluate.
+func (m *RankEvalDCG) K(k int) *RankEvalDCG {
+	m.k = &k
+	return m
+}
+
+// Normalize indicates whether to compute the normalized DCG (nDCG).
+func (m *RankEvalDCG) Normalize(normalize bool) *RankEvalDCG {
+	m.normalize = &normalize
+	return m
+}
+
+// Source returns the JSON-serializable metric.
+func (m *RankEvalDCG) Source() (interface{}, error) {
+	params := make(map[string]interface{})
+	if m.k != nil {
+		params["k"] = *m.k
+	}
+	if m.normalize != nil {
+		params["normalize"] = *m.normalize
+	}
+	return map[string]interface{}{"dcg": params}, nil
+}
+
+// -- Expected reciprocal rank --
+
+// RankEvalExpectedReciprocalRank computes the expected reciprocal rank
+// (ERR) of the top k search results.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/current/search-rank-eval.html#_expected_reciprocal_rank_err
+// for details.
+type RankEvalExpectedReciprocalRank struct {
+	maximumRelevance int
+	k                *int
+}
+
+// NewRankEvalExpectedReciprocalRank creates a new RankEvalExpectedReciprocalRank
+// metric. The maximum relevance is the highest rating used in the ratings.
+func NewRankEvalExpectedReciprocalRank(maximumRelevance int) *RankEvalExpectedReciprocalRank {
+	return &RankEvalExpectedReciprocalRank{
+		maximumRelevance: maximumRelevance,
+	}
+}
+
+// K sets the number of top hits to evaluate.
+func (m *RankEvalExpectedReciprocalRank) K(k int) *RankEvalExpectedReciprocalRank {
+	m.k = &k
+	return m
+}
+
+// Source returns the JSON-serializable metric.
+func (m *RankEvalExpectedReciprocalRank) Source() (interface{}, error) {
+	params := make(map[string]interface{})
+	params["maximum_relevance"] = m.maximumRelevance
+	if m.k != nil {
+		params["k"] = *m.k
+	}
+	return map[string]interface{}{"expected_reciprocal_rank": params}, nil
+}
@@ -0,0 +1,56 @@
+// Copyright 2012-present Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestRankEvalMetricSource(t *testing.T) {
+	tests := []struct {
+		Metric   RankEvalMetric
+		Expected string
+	}{
+		{
+			NewRankEvalPrecision(),
+			`{"precision":{}}`,
+		},
+		{
+			NewRankEvalPrecision().K(20).RelevantRatingThreshold(1).IgnoreUnlabeled(false),
+			`{"precision":{"ignore_unlabeled":false,"k":20,"relevant_rating_threshold":1}}`,
+		},
+		{
+			NewRankEvalRecall().K(20).RelevantRatingThreshold(1),
+			`{"recall":{"k":20,"relevant_rating_threshold":1}}`,
+		},
+		{
+			NewRankEvalMeanReciprocalRank().K(20).RelevantRatingThreshold(1),
+			`{"mean_reciprocal_rank":{"k":20,"relevant_rating_threshold":1}}`,
+		},
+		{
+			NewRankEvalDCG().K(20).Normalize(true),
+			`{"dcg":{"k":20,"normalize":true}}`,
+		},
+		{
+			NewRankEvalExpectedReciprocalRank(3).K(20),
+			`{"expected_reciprocal_rank":{"k":20,"maximum_relevance":3}}`,
+		},
+	}
+
+	for i, test := range tests {
+		src, err := test.Metric.Source()
+		if err != nil {
+			t.Fatalf("case #%d: %v", i+1, err)
+		}
+		data, err := json.Marshal(src)
+		if err != nil {
+			t.Fatalf("case #%d: marshaling to JSON failed: %v", i+1, err)
+		}
+		if want, have := test.Expected, string(data); want != have {
+			t.Errorf("case #%d: expected\n%s\n,got:\n%s", i+1, want, have)
+		}
+	}
+}
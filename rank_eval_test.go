@@ -0,0 +1,184 @@
+// Copyright 2012-present Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"golang.org/x/net/context"
+)
+
+func TestRankEvalBuildURL(t *testing.T) {
+	client := setupTestClient(t)
+
+	tests := []struct {
+		Service       *RankEvalService
+		ExpectedPath  string
+		ExpectedQuery string
+	}{
+		{
+			client.RankEval(),
+			"/_rank_eval",
+			"",
+		},
+		{
+			client.RankEval("twitter", "blog"),
+			"/twitter%2Cblog/_rank_eval",
+			"",
+		},
+		{
+			client.RankEval("twitter").IgnoreUnavailable(true).SearchType("dfs_query_then_fetch"),
+			"/twitter/_rank_eval",
+			"ignore_unavailable=true&search_type=dfs_query_then_fetch",
+		},
+	}
+
+	for i, test := range tests {
+		path, params, err := test.Service.buildURL()
+		if err != nil {
+			t.Fatalf("case #%d: %v", i+1, err)
+		}
+		if want, have := test.ExpectedPath, path; want != have {
+			t.Errorf("case #%d: expected path %q; got: %q", i+1, want, have)
+		}
+		if want, have := test.ExpectedQuery, params.Encode(); want != have {
+			t.Errorf("case #%d: expected query %q; got: %q", i+1, want, have)
+		}
+	}
+}
+
+func TestRankEvalValidate(t *testing.T) {
+	client := setupTestClient(t)
+
+	if err := client.RankEval("twitter").Validate(); err == nil {
+		t.Fatal("expected error when spec is missing")
+	}
+	if err := client.RankEval("twitter").Spec(NewRankEvalSpec().Metric(NewRankEvalPrecision())).Validate(); err == nil {
+		t.Fatal("expected error when requests are missing")
+	}
+	item := NewRankEvalRequestItem("golang_query").Request(NewSearchSource().Query(NewMatchQuery("message", "golang")))
+	if err := client.RankEval("twitter").Spec(NewRankEvalSpec().Requests(item)).Validate(); err == nil {
+		t.Fatal("expected error when metric is missing")
+	}
+	if err := client.RankEval("twitter").Spec(NewRankEvalSpec().Requests(NewRankEvalRequestItem("golang_query")).Metric(NewRankEvalPrecision())).Validate(); err == nil {
+		t.Fatal("expected error when request of an item is missing")
+	}
+	if err := client.RankEval("twitter").Spec(NewRankEvalSpec().Requests(item).Metric(NewRankEvalPrecision())).Validate(); err != nil {
+		t.Fatalf("expected no error; got: %v", err)
+	}
+}
+
+func TestRankEvalSpecSource(t *testing.T) {
+	spec := NewRankEvalSpec().
+		Requests(
+			NewRankEvalRequestItem("golang_query").
+				Request(NewSearchSource().Query(NewMatchQuery("message", "golang"))).
+				Ratings(
+					RankEvalRating{Index: "twitter", Id: "1", Rating: 1},
+					RankEvalRating{Index: "twitter", Id: "2", Rating: 0},
+				),
+			NewRankEvalRequestItem("elastic_query").
+				Request(NewSearchSource().Query(NewMatchQuery("message", "elasticsearch"))),
+		).
+		Metric(NewRankEvalPrecision().K(10).RelevantRatingThreshold(1)).
+		MaxConcurrentSearches(5)
+	src, err := spec.Source()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(src)
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"max_concurrent_searches":5,"metric":{"precision":{"k":10,"relevant_rating_threshold":1}},"requests":[{"id":"golang_query","ratings":[{"_index":"twitter","_id":"1","rating":1},{"_index":"twitter","_id":"2","rating":0}],"request":{"query":{"match":{"message":{"query":"golang"}}}}},{"id":"elastic_query","ratings":[],"request":{"query":{"match":{"message":{"query":"elasticsearch"}}}}}]}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
+
+func TestRankEvalDo(t *testing.T) {
+	var path string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if _, err := ioutil.ReadAll(r.Body); err != nil {
+			t.Error(err)
+		}
+		path = r.URL.Path
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{
+			"metric_score": 0.5,
+			"details": {
+				"golang_query": {
+					"metric_score": 0.5,
+					"unrated_docs": [
+						{"_index": "twitter", "_id": "3"}
+					],
+					"hits": [
+						{"hit": {"_index": "twitter", "_type": "tweet", "_id": "1", "_score": 1.2}, "rating": 1},
+						{"hit": {"_index": "twitter", "_type": "tweet", "_id": "3", "_score": 0.8}, "rating": null}
+					],
+					"metric_details": {
+						"precision": {"relevant_docs_retrieved": 1, "docs_retrieved": 2}
+					}
+				}
+			},
+			"failures": {}
+		}`)
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(SetURL(ts.URL), SetSniff(false), SetHealthcheck(false), SetMaxRetries(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	spec := NewRankEvalSpec().
+		Requests(NewRankEvalRequestItem("golang_query").
+			Request(NewSearchSource().Query(NewMatchQuery("message", "golang"))).
+			Ratings(RankEvalRating{Index: "twitter", Id: "1", Rating: 1})).
+		Metric(NewRankEvalPrecision().K(2))
+	res, err := client.RankEval("twitter").Spec(spec).Do(context.TODO())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, have := "/twitter/_rank_eval", path; want != have {
+		t.Errorf("expected path %q; got: %q", want, have)
+	}
+	if want, have := 0.5, res.MetricScore; want != have {
+		t.Errorf("expected metric_score %v; got: %v", want, have)
+	}
+	details, ok := res.Details["golang_query"]
+	if !ok {
+		t.Fatal("expected details for golang_query")
+	}
+	if want, have := 0.5, details.MetricScore; want != have {
+		t.Errorf("expected metric_score %v; got: %v", want, have)
+	}
+	if want, have := 1, len(details.UnratedDocs); want != have {
+		t.Fatalf("expected %d unrated docs; got: %d", want, have)
+	}
+	if want, have := "3", details.UnratedDocs[0].Id; want != have {
+		t.Errorf("expected unrated doc %q; got: %q", want, have)
+	}
+	if want, have := 2, len(details.Hits); want != have {
+		t.Fatalf("expected %d hits; got: %d", want, have)
+	}
+	if details.Hits[0].Hit == nil || details.Hits[0].Hit.Id != "1" {
+		t.Errorf("expected first hit with id %q; got: %v", "1", details.Hits[0].Hit)
+	}
+	if details.Hits[0].Rating == nil || *details.Hits[0].Rating != 1 {
+		t.Errorf("expected first hit to be rated 1; got: %v", details.Hits[0].Rating)
+	}
+	if details.Hits[1].Rating != nil {
+		t.Errorf("expected second hit to be unrated; got: %v", *details.Hits[1].Rating)
+	}
+	if _, ok := details.MetricDetails["precision"]; !ok {
+		t.Errorf("expected precision metric details; got: %v", details.MetricDetails)
+	}
+}
@@ -0,0 +1,141 @@
+// Copyright 2012-present Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+import (
+	"encoding/json"
+	"errors"
+	"net/url"
+
+	"golang.org/x/net/context"
+
+	"gopkg.in/olivere/elastic.v5/uritemplates"
+)
+
+// RenderSearchTemplateService renders a search template with the
+// given parameters and returns the resulting search request body,
+// without executing it.
+// See https://www.elastic.co/guide/en/elasticsearch/reference/current/render-search-template-api.html
+type RenderSearchTemplateService struct {
+	client *Client
+	pretty bool
+	id     string
+	source interface{}
+	params map[string]interface{}
+}
+
+// NewRenderSearchTemplateService creates a new RenderSearchTemplateService.
+func NewRenderSearchTemplateService(client *Client) *RenderSearchTemplateService {
+	return &RenderSearchTemplateService{
+		client: client,
+	}
+}
+
+// Id is the id of a stored search template to render.
+func (s *RenderSearchTemplateService) Id(id string) *RenderSearchTemplateService {
+	s.id = id
+	return s
+}
+
+// Source is an inline search template to render. It can be a string or
+// anything that serializes to a JSON object.
+func (s *RenderSearchTemplateService) Source(source interface{}) *RenderSearchTemplateService {
+	s.source = source
+	return s
+}
+
+// Params are the parameters used to render the template.
+func (s *RenderSearchTemplateService) Params(params map[string]interface{}) *RenderSearchTemplateService {
+	s.params = params
+	return s
+}
+
+// Pretty indicates that the JSON response be indented and human readable.
+func (s *RenderSearchTemplateService) Pretty(pretty bool) *RenderSearchTemplateService {
+	s.pretty = pretty
+	return s
+}
+
+// buildURL builds the URL for the operation.
+func (s *RenderSearchTemplateService) buildURL() (string, url.Values, error) {
+	// Build URL
+	var (
+		path string
+		err  error
+	)
+	if s.id != "" {
+		path, err = uritemplates.Expand("/_render/template/{id}", map[string]string{
+			"id": s.id,
+		})
+	} else {
+		path = "/_render/template"
+	}
+	if err != nil {
+		return "", url.Values{}, err
+	}
+
+	// Add query string parameters
+	params := url.Values{}
+	if s.pretty {
+		params.Set("pretty", "1")
+	}
+	return path, params, nil
+}
+
+// Validate checks if the operation is valid.
+func (s *RenderSearchTemplateService) Validate() error {
+	if s.id == "" && s.source == nil {
+		return errors.New("elastic: either Id or Source is required in RenderSearchTemplateService")
+	}
+	if s.id != "" && s.source != nil {
+		return errors.New("elastic: Id and Source are mutually exclusive in RenderSearchTemplateService")
+	}
+	return nil
+}
+
+// getBody returns the body of the request. The stored template id is
+// part of the URL, so only an inline source ends up in the body.
+func (s *RenderSearchTemplateService) getBody() interface{} {
+	body := make(map[string]interface{})
+	if s.source != nil {
+		body["source"] = s.source
+	}
+	if len(s.params) > 0 {
+		body["params"] = s.params
+	}
+	return body
+}
+
+// Do executes the operation.
+func (s *RenderSearchTemplateService) Do(ctx context.Context) (*RenderSearchTemplateResponse, error) {
+	// Check pre-conditions
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+
+	// Get URL for request
+	path, params, err := s.buildURL()
+	if err != nil {
+		return nil, err
+	}
+
+	// Get HTTP response
+	res, err := s.client.PerformRequest(ctx, "POST", path, params, s.getBody())
+	if err != nil {
+		return nil, err
+	}
+
+	// Return operation response
+	ret := new(RenderSearchTemplateResponse)
+	if err := s.client.decoder.Decode(res.Body, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// RenderSearchTemplateResponse is the response of RenderSearchTemplateService.Do.
+type RenderSearchTemplateResponse struct {
+	TemplateOutput json.RawMessage `json:"template_output"`
+}
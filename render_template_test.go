@@ -0,0 +1,127 @@
+// Copyright 2012-present Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"golang.org/x/net/context"
+)
+
+func TestRenderSearchTemplateBuildURL(t *testing.T) {
+	client := setupTestClient(t)
+
+	tests := []struct {
+		Id       string
+		Expected string
+	}{
+		{
+			"",
+			"/_render/template",
+		},
+		{
+			"my-template",
+			"/_render/template/my-template",
+		},
+	}
+
+	for i, test := range tests {
+		path, _, err := client.RenderSearchTemplate().Id(test.Id).buildURL()
+		if err != nil {
+			t.Errorf("case #%d: %v", i+1, err)
+			continue
+		}
+		if path != test.Expected {
+			t.Errorf("case #%d: expected %q; got: %q", i+1, test.Expected, path)
+		}
+	}
+}
+
+func TestRenderSearchTemplateValidate(t *testing.T) {
+	client := setupTestClient(t)
+
+	if err := client.RenderSearchTemplate().Validate(); err == nil {
+		t.Fatal("expected error when neither id nor source is given")
+	}
+	if err := client.RenderSearchTemplate().Id("tmpl").Source(`{}`).Validate(); err == nil {
+		t.Fatal("expected error when both id and source are given")
+	}
+}
+
+func TestRenderSearchTemplateBody(t *testing.T) {
+	client := setupTestClient(t)
+
+	tests := []struct {
+		Service  *RenderSearchTemplateService
+		Expected string
+	}{
+		{
+			client.RenderSearchTemplate().
+				Source(map[string]interface{}{
+					"query": map[string]interface{}{
+						"match": map[string]interface{}{"{{field}}": "{{value}}"},
+					},
+				}).
+				Params(map[string]interface{}{"field": "title", "value": "golang"}),
+			`{"params":{"field":"title","value":"golang"},"source":{"query":{"match":{"{{field}}":"{{value}}"}}}}`,
+		},
+		{
+			client.RenderSearchTemplate().Id("my-template").
+				Params(map[string]interface{}{"user": "olivere"}),
+			`{"params":{"user":"olivere"}}`,
+		},
+	}
+
+	for i, test := range tests {
+		data, err := json.Marshal(test.Service.getBody())
+		if err != nil {
+			t.Fatalf("case #%d: %v", i+1, err)
+		}
+		got := string(data)
+		if got != test.Expected {
+			t.Errorf("case #%d: expected\n%s\n,got:\n%s", i+1, test.Expected, got)
+		}
+	}
+}
+
+func TestRenderSearchTemplateDo(t *testing.T) {
+	var path, body string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			t.Error(err)
+		}
+		path, body = r.URL.Path, string(data)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"template_output":{"query":{"term":{"user":"olivere"}}}}`)
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(SetURL(ts.URL), SetSniff(false), SetHealthcheck(false), SetMaxRetries(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := client.RenderSearchTemplate().
+		Id("my-template").
+		Params(map[string]interface{}{"user": "olivere"}).
+		Do(context.TODO())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, have := "/_render/template/my-template", path; want != have {
+		t.Errorf("expected path %q; got: %q", want, have)
+	}
+	if want, have := `{"params":{"user":"olivere"}}`, body; want != have {
+		t.Errorf("expected body %s; got: %s", want, have)
+	}
+	if want, have := `{"query":{"term":{"user":"olivere"}}}`, string(res.TemplateOutput); want != have {
+		t.Errorf("expected template output %s; got: %s", want, have)
+	}
+}
@@ -6,6 +6,7 @@ package elastic
 
 import (
 	"encoding/json"
+	"io"
 	"io/ioutil"
 	"net/http"
 )
@@ -41,3 +42,69 @@ func (c *Client) newResponse(res *http.Response) (*Response, error) {
 	}
 	return r, nil
 }
+
+// newResponseInto creates a new response from the HTTP response and
+// decodes its body into result, if result is not nil. If the decoder
+// of the client is a ReaderDecoder, the body is decoded directly from
+// the HTTP response without reading it into memory first, and the
+// Body of the returned Response is nil.
+func (c *Client) newResponseInto(res *http.Response, result interface{}) (*Response, error) {
+	if result == nil {
+		return c.newResponse(res)
+	}
+	if dec, ok := c.decoder.(ReaderDecoder); ok {
+		r := &Response{
+			StatusCode: res.StatusCode,
+			Header:     res.Header,
+		}
+		if res.Body != nil {
+			if err := dec.DecodeReader(res.Body, result); err != nil {
+				return nil, err
+			}
+		}
+		return r, nil
+	}
+	r, err := c.newResponse(res)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.decoder.Decode(r.Body, result); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// limitedBody wraps a response body and fails with ErrResponseTooLarge
+// when more than n bytes are read from it.
+type limitedBody struct {
+	rc io.ReadCloser
+	n  int64 // bytes remaining; negative if the limit was exceeded
+}
+
+// Read implements io.Reader.
+func (b *limitedBody) Read(p []byte) (int, error) {
+	if b.n < 0 {
+		return 0, ErrResponseTooLarge
+	}
+	if b.n == 0 {
+		// Check whether the body has more data than allowed
+		var buf [1]byte
+		n, err := b.rc.Read(buf[:])
+		if n > 0 {
+			b.n = -1
+			return 0, ErrResponseTooLarge
+		}
+		return 0, err
+	}
+	if int64(len(p)) > b.n {
+		p = p[:b.n]
+	}
+	n, err := b.rc.Read(p)
+	b.n -= int64(n)
+	return n, err
+}
+
+// Close implements io.Closer.
+func (b *limitedBody) Close() error {
+	return b.rc.Close()
+}
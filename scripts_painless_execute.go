@@ -0,0 +1,220 @@
+// Copyright 2012-present Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+import (
+	"fmt"
+	"net/url"
+
+	"golang.org/x/net/context"
+)
+
+// ScriptsPainlessExecuteService executes a Painless script and returns
+// its result. It is useful to test scripts during development.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/painless/6.3/painless-execute-api.html
+// for details.
+type ScriptsPainlessExecuteService struct {
+	client       *Client
+	pretty       bool
+	script       *Script
+	context      string
+	contextSetup *PainlessContextSetup
+	bodyJson     interface{}
+	bodyString   string
+}
+
+// NewScriptsPainlessExecuteService creates a new ScriptsPainlessExecuteService.
+func NewScriptsPainlessExecuteService(client *Client) *ScriptsPainlessExecuteService {
+	return &ScriptsPainlessExecuteService{
+		client: client,
+	}
+}
+
+// Script is the Painless script to execute.
+func (s *ScriptsPainlessExecuteService) Script(script *Script) *ScriptsPainlessExecuteService {
+	s.script = script
+	return s
+}
+
+// Context is the context the script is executed in, e.g.
+// "painless_test" (default), "filter", or "score".
+func (s *ScriptsPainlessExecuteService) Context(context string) *ScriptsPainlessExecuteService {
+	s.context = context
+	return s
+}
+
+// ContextSetup specifies the index, document, and query to use with the
+// "filter" and "score" contexts.
+func (s *ScriptsPainlessExecuteService) ContextSetup(contextSetup *PainlessContextSetup) *ScriptsPainlessExecuteService {
+	s.contextSetup = contextSetup
+	return s
+}
+
+// Pretty indicates that the JSON response be indented and human readable.
+func (s *ScriptsPainlessExecuteService) Pretty(pretty bool) *ScriptsPainlessExecuteService {
+	s.pretty = pretty
+	return s
+}
+
+// BodyJson is the request body, defined as a JSON-serializable document.
+// It overrides Script, Context, and ContextSetup.
+func (s *ScriptsPainlessExecuteService) BodyJson(body interface{}) *ScriptsPainlessExecuteService {
+	s.bodyJson = body
+	return s
+}
+
+// BodyString is the request body, specified as a string.
+// It overrides Script, Context, and ContextSetup.
+func (s *ScriptsPainlessExecuteService) BodyString(body string) *ScriptsPainlessExecuteService {
+	s.bodyString = body
+	return s
+}
+
+// buildURL builds the URL for the operation.
+func (s *ScriptsPainlessExecuteService) buildURL() (string, url.Values, error) {
+	// Build URL
+	path := "/_scripts/painless/_execute"
+
+	// Add query string parameters
+	params := url.Values{}
+	if s.pretty {
+		params.Set("pretty", "1")
+	}
+	return path, params, nil
+}
+
+// Validate checks if the operation is valid.
+func (s *ScriptsPainlessExecuteService) Validate() error {
+	var invalid []string
+	if s.bodyString == "" && s.bodyJson == nil && s.script == nil {
+		invalid = append(invalid, "Script")
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf("missing required fields: %v", invalid)
+	}
+	return nil
+}
+
+// getBody returns the body of the request.
+func (s *ScriptsPainlessExecuteService) getBody() (interface{}, error) {
+	if s.bodyJson != nil {
+		return s.bodyJson, nil
+	}
+	if s.bodyString != "" {
+		return s.bodyString, nil
+	}
+
+	body := make(map[string]interface{})
+	src, err := s.script.Source()
+	if err != nil {
+		return nil, err
+	}
+	body["script"] = src
+	if s.context != "" {
+		body["context"] = s.context
+	}
+	if s.contextSetup != nil {
+		src, err := s.contextSetup.Source()
+		if err != nil {
+			return nil, err
+		}
+		body["context_setup"] = src
+	}
+	return body, nil
+}
+
+// Do executes the operation.
+func (s *ScriptsPainlessExecuteService) Do(ctx context.Context) (*ScriptsPainlessExecuteResponse, error) {
+	// Check pre-conditions
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+
+	// Get URL for request
+	path, params, err := s.buildURL()
+	if err != nil {
+		return nil, err
+	}
+
+	// Setup HTTP request body
+	body, err := s.getBody()
+	if err != nil {
+		return nil, err
+	}
+
+	// Get HTTP response
+	res, err := s.client.PerformRequest(ctx, "POST", path, params, body)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return operation response
+	ret := new(ScriptsPainlessExecuteResponse)
+	if err := s.client.decoder.Decode(res.Body, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// ScriptsPainlessExecuteResponse is the response of ScriptsPainlessExecuteService.Do.
+// The type of Result depends on the context: It is e.g. a string in the
+// "painless_test" context, a bool in the "filter" context, and a float64
+// in the "score" context. Scripts returning a list yield a []interface{}.
+type ScriptsPainlessExecuteResponse struct {
+	Result interface{} `json:"result"`
+}
+
+// -- Context setup --
+
+// PainlessContextSetup specifies the environment of a script executed
+// with ScriptsPainlessExecuteService.
+type PainlessContextSetup struct {
+	index    string
+	document interface{}
+	query    Query
+}
+
+// NewPainlessContextSetup creates a new PainlessContextSetup.
+func NewPainlessContextSetup() *PainlessContextSetup {
+	return &PainlessContextSetup{}
+}
+
+// Index whose mappings are used to index the document.
+func (c *PainlessContextSetup) Index(index string) *PainlessContextSetup {
+	c.index = index
+	return c
+}
+
+// Document is indexed temporarily and made available to the script.
+func (c *PainlessContextSetup) Document(document interface{}) *PainlessContextSetup {
+	c.document = document
+	return c
+}
+
+// Query is used in the "score" context.
+func (c *PainlessContextSetup) Query(query Query) *PainlessContextSetup {
+	c.query = query
+	return c
+}
+
+// Source returns the serializable JSON for the context setup.
+func (c *PainlessContextSetup) Source() (interface{}, error) {
+	source := make(map[string]interface{})
+	if c.index != "" {
+		source["index"] = c.index
+	}
+	if c.document != nil {
+		source["document"] = c.document
+	}
+	if c.query != nil {
+		src, err := c.query.Source()
+		if err != nil {
+			return nil, err
+		}
+		source["query"] = src
+	}
+	return source, nil
+}
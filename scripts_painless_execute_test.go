@@ -0,0 +1,90 @@
+// Copyright 2012-present Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestScriptsPainlessExecuteURL(t *testing.T) {
+	client := setupTestClient(t)
+
+	path, _, err := client.ScriptsPainlessExecute().buildURL()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, have := "/_scripts/painless/_execute", path; want != have {
+		t.Errorf("expected %q; got: %q", want, have)
+	}
+}
+
+func TestScriptsPainlessExecuteWithPainlessTestContext(t *testing.T) {
+	client := setupTestClient(t)
+
+	svc := client.ScriptsPainlessExecute().
+		Script(NewScript("params.count / params.total").Param("count", 100).Param("total", 1000))
+	body, err := svc.getBody()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(body)
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"script":{"inline":"params.count / params.total","params":{"count":100,"total":1000}}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+
+	var res ScriptsPainlessExecuteResponse
+	if err := json.Unmarshal([]byte(`{"result":"0.1"}`), &res); err != nil {
+		t.Fatal(err)
+	}
+	if want, have := "0.1", res.Result; want != have {
+		t.Errorf("expected result %v; got: %v", want, have)
+	}
+}
+
+func TestScriptsPainlessExecuteWithFilterContext(t *testing.T) {
+	client := setupTestClient(t)
+
+	svc := client.ScriptsPainlessExecute().
+		Script(NewScript("doc['field'].value.startsWith(params.prefix)").Param("prefix", "fo")).
+		Context("filter").
+		ContextSetup(NewPainlessContextSetup().
+			Index("my-index").
+			Document(map[string]interface{}{"field": "four"}))
+	body, err := svc.getBody()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(body)
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"context":"filter","context_setup":{"document":{"field":"four"},"index":"my-index"},"script":{"inline":"doc['field'].value.startsWith(params.prefix)","params":{"prefix":"fo"}}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+
+	var res ScriptsPainlessExecuteResponse
+	if err := json.Unmarshal([]byte(`{"result":true}`), &res); err != nil {
+		t.Fatal(err)
+	}
+	if want, have := true, res.Result; want != have {
+		t.Errorf("expected result %v; got: %v", want, have)
+	}
+}
+
+func TestScriptsPainlessExecuteValidate(t *testing.T) {
+	client := setupTestClient(t)
+
+	if err := client.ScriptsPainlessExecute().Validate(); err == nil {
+		t.Error("expected Validate to fail without a script")
+	}
+}
@@ -240,6 +240,39 @@ func (s *ScrollService) Do(ctx context.Context) (*SearchResult, error) {
 	return s.next(ctx)
 }
 
+// Each drives the scroll and invokes fn for every hit, until all hits
+// have been consumed, fn returns an error, or ctx is cancelled. The first
+// error is returned. Each clears the scroll before returning, regardless
+// of the outcome.
+func (s *ScrollService) Each(ctx context.Context, fn func(hit *SearchHit) error) (err error) {
+	defer func() {
+		// Clear with a fresh context, as ctx might already be cancelled
+		if cerr := s.Clear(context.Background()); cerr != nil && err == nil {
+			err = cerr
+		}
+	}()
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		res, err := s.Do(ctx)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		for _, hit := range res.Hits.Hits {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			if err := fn(hit); err != nil {
+				return err
+			}
+		}
+	}
+}
+
 // Clear cancels the current scroll operation. If you don't do this manually,
 // the scroll will be expired automatically by Elasticsearch. You can control
 // how long a scroll cursor is kept alive with the KeepAlive func.
@@ -284,18 +317,20 @@ func (s *ScrollService) first(ctx context.Context) (*SearchResult, error) {
 	}
 
 	// Get HTTP response
-	res, err := s.client.PerformRequest(ctx, "POST", path, params, body)
+	ret := new(SearchResult)
+	res, err := s.client.performRequest(ctx, "POST", path, params, body, ret)
 	if err != nil {
 		return nil, err
 	}
 
 	// Return operation response
-	ret := new(SearchResult)
-	if err := s.client.decoder.Decode(res.Body, ret); err != nil {
-		return nil, err
-	}
+	ret.Header = res.Header
 	s.mu.Lock()
-	s.scrollId = ret.ScrollId
+	if ret.ScrollId != "" {
+		// Elasticsearch may return a new scroll id with every response,
+		// so always continue with the latest one
+		s.scrollId = ret.ScrollId
+	}
 	s.mu.Unlock()
 	if ret.Hits == nil || len(ret.Hits.Hits) == 0 {
 		return nil, io.EOF
@@ -398,18 +433,20 @@ func (s *ScrollService) next(ctx context.Context) (*SearchResult, error) {
 	}
 
 	// Get HTTP response
-	res, err := s.client.PerformRequest(ctx, "POST", path, params, body)
+	ret := new(SearchResult)
+	res, err := s.client.performRequest(ctx, "POST", path, params, body, ret)
 	if err != nil {
 		return nil, err
 	}
 
 	// Return operation response
-	ret := new(SearchResult)
-	if err := s.client.decoder.Decode(res.Body, ret); err != nil {
-		return nil, err
-	}
+	ret.Header = res.Header
 	s.mu.Lock()
-	s.scrollId = ret.ScrollId
+	if ret.ScrollId != "" {
+		// Elasticsearch may return a new scroll id with every response,
+		// so always continue with the latest one
+		s.scrollId = ret.ScrollId
+	}
 	s.mu.Unlock()
 	if ret.Hits == nil || len(ret.Hits.Hits) == 0 {
 		return nil, io.EOF
@@ -6,8 +6,13 @@ package elastic
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
 	"io"
-	_ "net/http"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
 	"testing"
 
 	"golang.org/x/net/context"
@@ -386,3 +391,264 @@ func TestScrollWithSlice(t *testing.T) {
 		t.Fatal("expected to fail")
 	}
 }
+
+func TestScrollUsesRotatedScrollId(t *testing.T) {
+	var (
+		mu       sync.Mutex
+		received []string // scroll ids received on /_search/scroll
+		pages    int
+	)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		if strings.HasPrefix(r.URL.Path, "/_search/scroll") {
+			var body struct {
+				ScrollId string `json:"scroll_id"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			received = append(received, body.ScrollId)
+		}
+		pages++
+		w.Header().Set("Content-Type", "application/json")
+		if pages > 3 {
+			fmt.Fprintf(w, `{"_scroll_id":"id-%d","hits":{"total":3,"hits":[]}}`, pages)
+			return
+		}
+		fmt.Fprintf(w, `{"_scroll_id":"id-%d","hits":{"total":3,"hits":[{"_id":"%d"}]}}`, pages, pages)
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(SetURL(ts.URL), SetSniff(false), SetHealthcheck(false), SetMaxRetries(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	svc := client.Scroll("twitter").Size(1)
+	for {
+		_, err := svc.Do(context.TODO())
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	expected := []string{"id-1", "id-2", "id-3"}
+	if want, have := len(expected), len(received); want != have {
+		t.Fatalf("expected %d scroll requests; got: %d (%v)", want, have, received)
+	}
+	for i := range expected {
+		if want, have := expected[i], received[i]; want != have {
+			t.Errorf("scroll request #%d: expected scroll id %q; got: %q", i+1, want, have)
+		}
+	}
+}
+
+func TestScrollWithSliceRequestBodies(t *testing.T) {
+	var (
+		mu     sync.Mutex
+		bodies []map[string]interface{}
+	)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		mu.Lock()
+		bodies = append(bodies, body)
+		n := len(bodies)
+		mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		if n > 1 {
+			fmt.Fprint(w, `{"_scroll_id":"id-2","hits":{"total":1,"hits":[]}}`)
+			return
+		}
+		fmt.Fprint(w, `{"_scroll_id":"id-1","hits":{"total":1,"hits":[{"_id":"1"}]}}`)
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(SetURL(ts.URL), SetSniff(false), SetHealthcheck(false), SetMaxRetries(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	svc := client.Scroll("twitter").Slice(NewSliceQuery().Id(1).Max(3)).Size(1)
+	if _, err := svc.Do(context.TODO()); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := svc.Do(context.TODO()); err != io.EOF {
+		t.Fatalf("expected io.EOF; got: %v", err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if want, have := 2, len(bodies); want != have {
+		t.Fatalf("expected %d requests; got: %d", want, have)
+	}
+
+	// First request must contain the slice
+	slice, ok := bodies[0]["slice"].(map[string]interface{})
+	if !ok {
+		t.Fatalf("expected slice in first request; got: %v", bodies[0])
+	}
+	if want, have := float64(1), slice["id"]; want != have {
+		t.Errorf("expected slice id = %v; got: %v", want, have)
+	}
+	if want, have := float64(3), slice["max"]; want != have {
+		t.Errorf("expected slice max = %v; got: %v", want, have)
+	}
+
+	// Continuation must only contain the scroll id (and keep alive)
+	if _, found := bodies[1]["slice"]; found {
+		t.Errorf("expected no slice in continuation; got: %v", bodies[1])
+	}
+	if want, have := "id-1", bodies[1]["scroll_id"]; want != have {
+		t.Errorf("expected scroll_id = %v; got: %v", want, have)
+	}
+	for key := range bodies[1] {
+		if key != "scroll_id" && key != "scroll" {
+			t.Errorf("expected continuation to only contain scroll and scroll_id; got: %q", key)
+		}
+	}
+}
+
+// newScrollPagesServer returns a server that returns two pages of two
+// hits each, followed by an empty page. It records the scroll ids cleared.
+func newScrollPagesServer(cleared *[]string, mu *sync.Mutex) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			ScrollId interface{} `json:"scroll_id"`
+		}
+		json.NewDecoder(r.Body).Decode(&body)
+
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == "DELETE" {
+			mu.Lock()
+			for _, id := range body.ScrollId.([]interface{}) {
+				*cleared = append(*cleared, id.(string))
+			}
+			mu.Unlock()
+			fmt.Fprint(w, `{"succeeded":true,"num_freed":1}`)
+			return
+		}
+		switch body.ScrollId {
+		case nil:
+			fmt.Fprint(w, `{"_scroll_id":"page-1","hits":{"total":4,"hits":[{"_id":"1"},{"_id":"2"}]}}`)
+		case "page-1":
+			fmt.Fprint(w, `{"_scroll_id":"page-2","hits":{"total":4,"hits":[{"_id":"3"},{"_id":"4"}]}}`)
+		default:
+			fmt.Fprint(w, `{"_scroll_id":"page-3","hits":{"total":4,"hits":[]}}`)
+		}
+	}))
+}
+
+func TestScrollEach(t *testing.T) {
+	var (
+		mu      sync.Mutex
+		cleared []string
+	)
+	ts := newScrollPagesServer(&cleared, &mu)
+	defer ts.Close()
+
+	client, err := NewClient(SetURL(ts.URL), SetSniff(false), SetHealthcheck(false), SetMaxRetries(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var ids []string
+	err = client.Scroll("twitter").Size(2).Each(context.TODO(), func(hit *SearchHit) error {
+		ids = append(ids, hit.Id)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, have := "1,2,3,4", strings.Join(ids, ","); want != have {
+		t.Errorf("expected hits %q; got: %q", want, have)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if want, have := "page-3", strings.Join(cleared, ","); want != have {
+		t.Errorf("expected cleared scroll ids %q; got: %q", want, have)
+	}
+}
+
+func TestScrollEachStopsOnCallbackError(t *testing.T) {
+	var (
+		mu      sync.Mutex
+		cleared []string
+	)
+	ts := newScrollPagesServer(&cleared, &mu)
+	defer ts.Close()
+
+	client, err := NewClient(SetURL(ts.URL), SetSniff(false), SetHealthcheck(false), SetMaxRetries(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	errStop := errors.New("stop")
+	var ids []string
+	err = client.Scroll("twitter").Size(2).Each(context.TODO(), func(hit *SearchHit) error {
+		ids = append(ids, hit.Id)
+		if hit.Id == "3" {
+			return errStop
+		}
+		return nil
+	})
+	if err != errStop {
+		t.Fatalf("expected error %v; got: %v", errStop, err)
+	}
+	if want, have := "1,2,3", strings.Join(ids, ","); want != have {
+		t.Errorf("expected hits %q; got: %q", want, have)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if want, have := "page-2", strings.Join(cleared, ","); want != have {
+		t.Errorf("expected cleared scroll ids %q; got: %q", want, have)
+	}
+}
+
+func TestScrollEachStopsOnContextCancel(t *testing.T) {
+	var (
+		mu      sync.Mutex
+		cleared []string
+	)
+	ts := newScrollPagesServer(&cleared, &mu)
+	defer ts.Close()
+
+	client, err := NewClient(SetURL(ts.URL), SetSniff(false), SetHealthcheck(false), SetMaxRetries(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.TODO())
+	defer cancel()
+	var ids []string
+	err = client.Scroll("twitter").Size(2).Each(ctx, func(hit *SearchHit) error {
+		ids = append(ids, hit.Id)
+		cancel()
+		return nil
+	})
+	if err != context.Canceled {
+		t.Fatalf("expected error %v; got: %v", context.Canceled, err)
+	}
+	if want, have := "1", strings.Join(ids, ","); want != have {
+		t.Errorf("expected hits %q; got: %q", want, have)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if want, have := "page-1", strings.Join(cleared, ","); want != have {
+		t.Errorf("expected cleared scroll ids %q; got: %q", want, have)
+	}
+}
@@ -6,7 +6,9 @@ package elastic
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"net/http"
 	"net/url"
 	"reflect"
 	"strings"
@@ -18,19 +20,20 @@ import (
 
 // Search for documents in Elasticsearch.
 type SearchService struct {
-	client            *Client
-	searchSource      *SearchSource
-	source            interface{}
-	pretty            bool
-	searchType        string
-	index             []string
-	typ               []string
-	routing           string
-	preference        string
-	requestCache      *bool
-	ignoreUnavailable *bool
-	allowNoIndices    *bool
-	expandWildcards   string
+	client                *Client
+	searchSource          *SearchSource
+	source                interface{}
+	pretty                bool
+	searchType            string
+	index                 []string
+	typ                   []string
+	routing               string
+	preference            string
+	requestCache          *bool
+	ignoreUnavailable     *bool
+	allowNoIndices        *bool
+	expandWildcards       string
+	ccsMinimizeRoundtrips *bool
 }
 
 // NewSearchService creates a new service for searching in Elasticsearch.
@@ -128,6 +131,15 @@ func (s *SearchService) RequestCache(requestCache bool) *SearchService {
 	return s
 }
 
+// CCSMinimizeRoundtrips indicates whether network round-trips between
+// the coordinating node and remote clusters should be minimized when
+// executing a cross-cluster search. When enabled, the response contains
+// the details of each cluster in SearchResult.Clusters.
+func (s *SearchService) CCSMinimizeRoundtrips(minimize bool) *SearchService {
+	s.ccsMinimizeRoundtrips = &minimize
+	return s
+}
+
 // Query sets the query to perform, e.g. MatchAllQuery.
 func (s *SearchService) Query(query Query) *SearchService {
 	s.searchSource = s.searchSource.Query(query)
@@ -186,6 +198,18 @@ func (s *SearchService) MinScore(minScore float64) *SearchService {
 	return s
 }
 
+// Collapse adds field collapsing.
+func (s *SearchService) Collapse(collapse *CollapseBuilder) *SearchService {
+	s.searchSource = s.searchSource.Collapse(collapse)
+	return s
+}
+
+// Knn adds a top-level approximate k-nearest neighbor search.
+func (s *SearchService) Knn(knn *KnnSearch) *SearchService {
+	s.searchSource = s.searchSource.Knn(knn)
+	return s
+}
+
 // From index to start the search from. Defaults to 0.
 func (s *SearchService) From(from int) *SearchService {
 	s.searchSource = s.searchSource.From(from)
@@ -205,6 +229,14 @@ func (s *SearchService) Explain(explain bool) *SearchService {
 	return s
 }
 
+// Profile sets the Profile API flag on the search source.
+// When enabled, a search executed by this service will return query
+// and aggregation profiling data.
+func (s *SearchService) Profile(profile bool) *SearchService {
+	s.searchSource = s.searchSource.Profile(profile)
+	return s
+}
+
 // Version indicates whether each search hit should be returned with
 // a version associated to it.
 func (s *SearchService) Version(version bool) *SearchService {
@@ -252,6 +284,14 @@ func (s *SearchService) StoredFields(fields ...string) *SearchService {
 	return s
 }
 
+// Fields adds one or more fields to return in the "fields" section
+// of each search hit. Use it together with FetchSource(false) to
+// return only those fields without the _source.
+func (s *SearchService) Fields(fields ...string) *SearchService {
+	s.searchSource = s.searchSource.Fields(fields...)
+	return s
+}
+
 // IgnoreUnavailable indicates whether the specified concrete indices
 // should be ignored when unavailable (missing or closed).
 func (s *SearchService) IgnoreUnavailable(ignoreUnavailable bool) *SearchService {
@@ -325,6 +365,9 @@ func (s *SearchService) buildURL() (string, url.Values, error) {
 	if s.ignoreUnavailable != nil {
 		params.Set("ignore_unavailable", fmt.Sprintf("%v", *s.ignoreUnavailable))
 	}
+	if s.ccsMinimizeRoundtrips != nil {
+		params.Set("ccs_minimize_roundtrips", fmt.Sprintf("%v", *s.ccsMinimizeRoundtrips))
+	}
 	return path, params, nil
 }
 
@@ -357,32 +400,127 @@ func (s *SearchService) Do(ctx context.Context) (*SearchResult, error) {
 		}
 		body = src
 	}
-	res, err := s.client.PerformRequest(ctx, "POST", path, params, body)
+	ret := new(SearchResult)
+	res, err := s.client.performRequest(ctx, "POST", path, params, body, ret)
 	if err != nil {
 		return nil, err
 	}
 
 	// Return search results
-	ret := new(SearchResult)
-	if err := s.client.decoder.Decode(res.Body, ret); err != nil {
-		return nil, err
-	}
+	ret.Header = res.Header
 	return ret, nil
 }
 
+// EachCompositeBucket pages through all buckets of the composite aggregation
+// of the search and invokes fn for every bucket. It executes the search
+// repeatedly, passing the after_key of each page on to the next request,
+// until no more buckets are returned. Elasticsearch versions before 6.3
+// do not return an after_key; the key of the last bucket is used instead.
+//
+// The search must contain exactly one top-level CompositeAggregation,
+// whose "after" parameter is modified while paging. Iteration stops with
+// the first error returned by fn, or when ctx is cancelled.
+func (s *SearchService) EachCompositeBucket(ctx context.Context, fn func(bucket *CompositeBucket) error) error {
+	if s.source != nil {
+		return errors.New("elastic: EachCompositeBucket does not support a manually specified Source")
+	}
+	var (
+		name string
+		agg  *CompositeAggregation
+	)
+	for n, a := range s.searchSource.aggregations {
+		if c, ok := a.(*CompositeAggregation); ok {
+			if agg != nil {
+				return errors.New("elastic: EachCompositeBucket supports only one composite aggregation")
+			}
+			name, agg = n, c
+		}
+	}
+	if agg == nil {
+		return errors.New("elastic: EachCompositeBucket requires a composite aggregation")
+	}
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		res, err := s.Do(ctx)
+		if err != nil {
+			return err
+		}
+		items, found := res.Aggregations.Composite(name)
+		if !found || items == nil || len(items.Buckets) == 0 {
+			return nil
+		}
+		for _, bucket := range items.Buckets {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			if err := fn(bucket); err != nil {
+				return err
+			}
+		}
+		after := items.AfterKey
+		if len(after) == 0 {
+			after = items.Buckets[len(items.Buckets)-1].Key
+		}
+		if len(after) == 0 {
+			return nil
+		}
+		agg.AggregateAfter(after)
+	}
+}
+
 // SearchResult is the result of a search in Elasticsearch.
 type SearchResult struct {
-	TookInMillis int64         `json:"took"`         // search time in milliseconds
-	ScrollId     string        `json:"_scroll_id"`   // only used with Scroll and Scan operations
-	Hits         *SearchHits   `json:"hits"`         // the actual search hits
-	Suggest      SearchSuggest `json:"suggest"`      // results from suggesters
-	Aggregations Aggregations  `json:"aggregations"` // results from aggregations
-	TimedOut     bool          `json:"timed_out"`    // true if the search timed out
+	Header       http.Header           `json:"-"`                   // HTTP header of the response, e.g. to inspect Warning headers
+	TookInMillis int64                 `json:"took"`                // search time in milliseconds
+	ScrollId     string                `json:"_scroll_id"`          // only used with Scroll and Scan operations
+	Hits         *SearchHits           `json:"hits"`                // the actual search hits
+	Suggest      SearchSuggest         `json:"suggest"`             // results from suggesters
+	Aggregations Aggregations          `json:"aggregations"`        // results from aggregations
+	TimedOut     bool                  `json:"timed_out"`           // true if the search timed out
+	Shards       *ShardsInfo           `json:"_shards,omitempty"`   // shard information, including failures of individual shards
+	Clusters     *SearchResultClusters `json:"_clusters,omitempty"` // cluster information of a cross-cluster search
+	Profile      *SearchProfile        `json:"profile,omitempty"`   // profiling results, if optional Profile API was active for this search
 	//Error        string        `json:"error,omitempty"` // used in MultiSearch only
 	// TODO double-check that MultiGet now returns details error information
 	Error *ErrorDetails `json:"error,omitempty"` // only used in MultiGet
 }
 
+// SearchResultClusters represents the clusters involved in a
+// cross-cluster search. Details are returned per cluster alias, with
+// "(local)" for the local cluster.
+type SearchResultClusters struct {
+	Total      int                                    `json:"total"`
+	Successful int                                    `json:"successful"`
+	Skipped    int                                    `json:"skipped"`
+	Running    int                                    `json:"running,omitempty"`
+	Partial    int                                    `json:"partial,omitempty"`
+	Failed     int                                    `json:"failed,omitempty"`
+	Details    map[string]*SearchResultClusterDetails `json:"details,omitempty"`
+}
+
+// SearchResultClusterDetails represents the outcome of a cross-cluster
+// search on a single cluster.
+type SearchResultClusterDetails struct {
+	Status       string          `json:"status"` // e.g. successful, skipped, running, partial, or failed
+	Indices      string          `json:"indices"`
+	TookInMillis int64           `json:"took,omitempty"`
+	TimedOut     bool            `json:"timed_out"`
+	Shards       *ShardsInfo     `json:"_shards,omitempty"`
+	Failures     []*ShardFailure `json:"failures,omitempty"`
+}
+
+// HasShardFailures returns true if any of the shards involved in the
+// search failed, even though the search itself succeeded.
+func (r *SearchResult) HasShardFailures() bool {
+	if r.Shards == nil {
+		return false
+	}
+	return r.Shards.Failed > 0 || len(r.Shards.Failures) > 0
+}
+
 // TotalHits is a convenience function to return the number of hits for
 // a search result.
 func (r *SearchResult) TotalHits() int64 {
@@ -401,6 +539,9 @@ func (r *SearchResult) Each(typ reflect.Type) []interface{} {
 	}
 	var slice []interface{}
 	for _, hit := range r.Hits.Hits {
+		if hit.Source == nil {
+			continue
+		}
 		v := reflect.New(typ).Elem()
 		if err := json.Unmarshal(*hit.Source, v.Addr().Interface()); err == nil {
 			slice = append(slice, v.Interface())
@@ -409,6 +550,25 @@ func (r *SearchResult) Each(typ reflect.Type) []interface{} {
 	return slice
 }
 
+// EachHit is a utility function to iterate over all hits that have a
+// source. Unlike Each, it avoids the cost of reflection by leaving the
+// decoding to fn, e.g. by calling hit.UnmarshalSource. Iteration stops
+// at the first error returned by fn, which is then returned by EachHit.
+func (r *SearchResult) EachHit(fn func(hit *SearchHit) error) error {
+	if r.Hits == nil {
+		return nil
+	}
+	for _, hit := range r.Hits.Hits {
+		if hit == nil || hit.Source == nil {
+			continue
+		}
+		if err := fn(hit); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // SearchHits specifies the list of search hits.
 type SearchHits struct {
 	TotalHits int64        `json:"total"`     // total number of hits found
@@ -416,6 +576,38 @@ type SearchHits struct {
 	Hits      []*SearchHit `json:"hits"`      // the actual hits returned
 }
 
+// UnmarshalJSON decodes JSON data and initializes a SearchHits structure.
+// The total number of hits is returned either as a plain number or,
+// in newer versions of Elasticsearch, as an object with a value and
+// a relation, e.g. {"value":10,"relation":"eq"}. Both are supported.
+func (h *SearchHits) UnmarshalJSON(data []byte) error {
+	var aux struct {
+		TotalHits json.RawMessage `json:"total"`
+		MaxScore  *float64        `json:"max_score"`
+		Hits      []*SearchHit    `json:"hits"`
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	h.MaxScore = aux.MaxScore
+	h.Hits = aux.Hits
+	h.TotalHits = 0
+	if len(aux.TotalHits) > 0 && string(aux.TotalHits) != "null" {
+		if aux.TotalHits[0] == '{' {
+			var total struct {
+				Value int64 `json:"value"`
+			}
+			if err := json.Unmarshal(aux.TotalHits, &total); err != nil {
+				return err
+			}
+			h.TotalHits = total.Value
+		} else if err := json.Unmarshal(aux.TotalHits, &h.TotalHits); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // SearchHit is a single hit.
 type SearchHit struct {
 	Score          *float64                       `json:"_score"`          // computed score
@@ -433,6 +625,7 @@ type SearchHit struct {
 	Explanation    *SearchExplanation             `json:"_explanation"`    // explains how the score was computed
 	MatchedQueries []string                       `json:"matched_queries"` // matched queries
 	InnerHits      map[string]*SearchHitInnerHits `json:"inner_hits"`      // inner hits with ES >= 1.5.0
+	Nested         *NestedHit                     `json:"_nested"`         // for nested inner hits
 
 	// Shard
 	// HighlightFields
@@ -440,10 +633,72 @@ type SearchHit struct {
 	// MatchedFilters
 }
 
+// UnmarshalSource decodes the source of the hit into v, like
+// json.Unmarshal. It returns ErrNoSource if the hit has no source.
+func (hit *SearchHit) UnmarshalSource(v interface{}) error {
+	if hit == nil || hit.Source == nil {
+		return ErrNoSource
+	}
+	return json.Unmarshal(*hit.Source, v)
+}
+
+// DecodeHitStrict decodes the source of hit into target, like
+// json.Unmarshal. In addition, it returns an error if any of the
+// requiredFields is missing from the source, e.g. because it was
+// excluded by source filtering. Nested fields can be specified
+// in dot notation, e.g. "user.name".
+func DecodeHitStrict(hit *SearchHit, target interface{}, requiredFields ...string) error {
+	if hit == nil || hit.Source == nil {
+		return ErrNoSource
+	}
+	if len(requiredFields) > 0 {
+		var doc map[string]interface{}
+		if err := json.Unmarshal(*hit.Source, &doc); err != nil {
+			return err
+		}
+		var missing []string
+		for _, field := range requiredFields {
+			if !hasSourceField(doc, field) {
+				missing = append(missing, field)
+			}
+		}
+		if len(missing) > 0 {
+			return fmt.Errorf("elastic: hit %q is missing required fields in source: %v", hit.Id, missing)
+		}
+	}
+	return json.Unmarshal(*hit.Source, target)
+}
+
+// hasSourceField returns true if the field, given in dot notation,
+// exists in the document.
+func hasSourceField(doc map[string]interface{}, field string) bool {
+	if _, found := doc[field]; found {
+		return true
+	}
+	parts := strings.SplitN(field, ".", 2)
+	if len(parts) < 2 {
+		return false
+	}
+	sub, ok := doc[parts[0]].(map[string]interface{})
+	if !ok {
+		return false
+	}
+	return hasSourceField(sub, parts[1])
+}
+
 type SearchHitInnerHits struct {
 	Hits *SearchHits `json:"hits"`
 }
 
+// NestedHit is the nested identity of an inner hit, i.e. the nested
+// field and the position of the nested object within the field.
+// Child is set for multi-level nested documents.
+type NestedHit struct {
+	Field  string     `json:"field"`
+	Offset int        `json:"offset,omitempty"`
+	Child  *NestedHit `json:"_nested,omitempty"`
+}
+
 // SearchExplanation explains how the score for a hit was computed.
 // See http://www.elasticsearch.org/guide/en/elasticsearch/reference/current/search-request-explain.html.
 type SearchExplanation struct {
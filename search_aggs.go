@@ -7,6 +7,7 @@ package elastic
 import (
 	"bytes"
 	"encoding/json"
+	"strings"
 )
 
 // Aggregations can be seen as a unit-of-work that build
@@ -173,6 +174,81 @@ func (a Aggregations) PercentileRanks(name string) (*AggregationPercentilesMetri
 	return nil, false
 }
 
+// Boxplot returns boxplot aggregation results.
+// See: https://www.elastic.co/guide/en/elasticsearch/reference/current/search-aggregations-metrics-boxplot-aggregation.html
+func (a Aggregations) Boxplot(name string) (*AggregationBoxplotMetric, bool) {
+	if raw, found := a[name]; found {
+		agg := new(AggregationBoxplotMetric)
+		if raw == nil {
+			return agg, true
+		}
+		if err := json.Unmarshal(*raw, agg); err == nil {
+			return agg, true
+		}
+	}
+	return nil, false
+}
+
+// MedianAbsoluteDeviation returns median absolute deviation aggregation results.
+// See: https://www.elastic.co/guide/en/elasticsearch/reference/current/search-aggregations-metrics-median-absolute-deviation-aggregation.html
+func (a Aggregations) MedianAbsoluteDeviation(name string) (*AggregationValueMetric, bool) {
+	if raw, found := a[name]; found {
+		agg := new(AggregationValueMetric)
+		if raw == nil {
+			return agg, true
+		}
+		if err := json.Unmarshal(*raw, agg); err == nil {
+			return agg, true
+		}
+	}
+	return nil, false
+}
+
+// StringStats returns string stats aggregation results.
+// See: https://www.elastic.co/guide/en/elasticsearch/reference/current/search-aggregations-metrics-string-stats-aggregation.html
+func (a Aggregations) StringStats(name string) (*AggregationStringStatsMetric, bool) {
+	if raw, found := a[name]; found {
+		agg := new(AggregationStringStatsMetric)
+		if raw == nil {
+			return agg, true
+		}
+		if err := json.Unmarshal(*raw, agg); err == nil {
+			return agg, true
+		}
+	}
+	return nil, false
+}
+
+// TTest returns t-test aggregation results.
+// See: https://www.elastic.co/guide/en/elasticsearch/reference/current/search-aggregations-metrics-ttest-aggregation.html
+func (a Aggregations) TTest(name string) (*AggregationValueMetric, bool) {
+	if raw, found := a[name]; found {
+		agg := new(AggregationValueMetric)
+		if raw == nil {
+			return agg, true
+		}
+		if err := json.Unmarshal(*raw, agg); err == nil {
+			return agg, true
+		}
+	}
+	return nil, false
+}
+
+// ScriptedMetric returns scripted metric aggregation results.
+// See: https://www.elastic.co/guide/en/elasticsearch/reference/current/search-aggregations-metrics-scripted-metric-aggregation.html
+func (a Aggregations) ScriptedMetric(name string) (*AggregationScriptedMetric, bool) {
+	if raw, found := a[name]; found {
+		agg := new(AggregationScriptedMetric)
+		if raw == nil {
+			return agg, true
+		}
+		if err := json.Unmarshal(*raw, agg); err == nil {
+			return agg, true
+		}
+	}
+	return nil, false
+}
+
 // TopHits returns top-hits aggregation results.
 // See: http://www.elasticsearch.org/guide/en/elasticsearch/reference/current/search-aggregations-metrics-top-hits-aggregation.html
 func (a Aggregations) TopHits(name string) (*AggregationTopHitsMetric, bool) {
@@ -293,6 +369,21 @@ func (a Aggregations) Children(name string) (*AggregationSingleBucket, bool) {
 	return nil, false
 }
 
+// Parent returns parent results.
+// See: https://www.elastic.co/guide/en/elasticsearch/reference/current/search-aggregations-bucket-parent-aggregation.html
+func (a Aggregations) Parent(name string) (*AggregationSingleBucket, bool) {
+	if raw, found := a[name]; found {
+		agg := new(AggregationSingleBucket)
+		if raw == nil {
+			return agg, true
+		}
+		if err := json.Unmarshal(*raw, agg); err == nil {
+			return agg, true
+		}
+	}
+	return nil, false
+}
+
 // Terms returns terms aggregation results.
 // See: http://www.elasticsearch.org/guide/en/elasticsearch/reference/current/search-aggregations-bucket-terms-aggregation.html
 func (a Aggregations) Terms(name string) (*AggregationBucketKeyItems, bool) {
@@ -338,6 +429,21 @@ func (a Aggregations) Sampler(name string) (*AggregationSingleBucket, bool) {
 	return nil, false
 }
 
+// DiversifiedSampler returns diversified_sampler aggregation results.
+// See: https://www.elastic.co/guide/en/elasticsearch/reference/current/search-aggregations-bucket-diversified-sampler-aggregation.html
+func (a Aggregations) DiversifiedSampler(name string) (*AggregationSingleBucket, bool) {
+	if raw, found := a[name]; found {
+		agg := new(AggregationSingleBucket)
+		if raw == nil {
+			return agg, true
+		}
+		if err := json.Unmarshal(*raw, agg); err == nil {
+			return agg, true
+		}
+	}
+	return nil, false
+}
+
 // Range returns range aggregation results.
 // See: http://www.elasticsearch.org/guide/en/elasticsearch/reference/current/search-aggregations-bucket-range-aggregation.html
 func (a Aggregations) Range(name string) (*AggregationBucketRangeItems, bool) {
@@ -398,6 +504,13 @@ func (a Aggregations) IPv4Range(name string) (*AggregationBucketRangeItems, bool
 	return nil, false
 }
 
+// IpRange returns IP range aggregation results, for both IPv4 and IPv6
+// ranges. It is the same as IPv4Range.
+// See: https://www.elastic.co/guide/en/elasticsearch/reference/current/search-aggregations-bucket-iprange-aggregation.html
+func (a Aggregations) IpRange(name string) (*AggregationBucketRangeItems, bool) {
+	return a.IPv4Range(name)
+}
+
 // Histogram returns histogram aggregation results.
 // See: http://www.elasticsearch.org/guide/en/elasticsearch/reference/current/search-aggregations-bucket-histogram-aggregation.html
 func (a Aggregations) Histogram(name string) (*AggregationBucketHistogramItems, bool) {
@@ -428,6 +541,21 @@ func (a Aggregations) DateHistogram(name string) (*AggregationBucketHistogramIte
 	return nil, false
 }
 
+// AutoDateHistogram returns auto date histogram aggregation results.
+// See: https://www.elastic.co/guide/en/elasticsearch/reference/current/search-aggregations-bucket-autodatehistogram-aggregation.html
+func (a Aggregations) AutoDateHistogram(name string) (*AggregationBucketHistogramItems, bool) {
+	if raw, found := a[name]; found {
+		agg := new(AggregationBucketHistogramItems)
+		if raw == nil {
+			return agg, true
+		}
+		if err := json.Unmarshal(*raw, agg); err == nil {
+			return agg, true
+		}
+	}
+	return nil, false
+}
+
 // GeoBounds returns geo-bounds aggregation results.
 // See: http://www.elasticsearch.org/guide/en/elasticsearch/reference/current/search-aggregations-metrics-geobounds-aggregation.html
 func (a Aggregations) GeoBounds(name string) (*AggregationGeoBoundsMetric, bool) {
@@ -443,6 +571,21 @@ func (a Aggregations) GeoBounds(name string) (*AggregationGeoBoundsMetric, bool)
 	return nil, false
 }
 
+// GeoLine returns geo-line aggregation results.
+// See: https://www.elastic.co/guide/en/elasticsearch/reference/current/search-aggregations-metrics-geo-line.html
+func (a Aggregations) GeoLine(name string) (*AggregationGeoLineMetric, bool) {
+	if raw, found := a[name]; found {
+		agg := new(AggregationGeoLineMetric)
+		if raw == nil {
+			return agg, true
+		}
+		if err := json.Unmarshal(*raw, agg); err == nil {
+			return agg, true
+		}
+	}
+	return nil, false
+}
+
 // GeoHash returns geo-hash aggregation results.
 // http://www.elasticsearch.org/guide/en/elasticsearch/reference/current/search-aggregations-bucket-geohashgrid-aggregation.html
 func (a Aggregations) GeoHash(name string) (*AggregationBucketKeyItems, bool) {
@@ -578,6 +721,21 @@ func (a Aggregations) CumulativeSum(name string) (*AggregationPipelineSimpleValu
 	return nil, false
 }
 
+// CumulativeCardinality returns a cumulative cardinality pipeline aggregation results.
+// See https://www.elastic.co/guide/en/elasticsearch/reference/current/search-aggregations-pipeline-cumulative-cardinality-aggregation.html
+func (a Aggregations) CumulativeCardinality(name string) (*AggregationPipelineSimpleValue, bool) {
+	if raw, found := a[name]; found {
+		agg := new(AggregationPipelineSimpleValue)
+		if raw == nil {
+			return agg, true
+		}
+		if err := json.Unmarshal(*raw, agg); err == nil {
+			return agg, true
+		}
+	}
+	return nil, false
+}
+
 // BucketScript returns bucket script pipeline aggregation results.
 // See https://www.elastic.co/guide/en/elasticsearch/reference/current/search-aggregations-pipeline-bucket-script-aggregation.html
 func (a Aggregations) BucketScript(name string) (*AggregationPipelineSimpleValue, bool) {
@@ -608,6 +766,114 @@ func (a Aggregations) SerialDiff(name string) (*AggregationPipelineSimpleValue,
 	return nil, false
 }
 
+// Composite returns composite bucket aggregation results.
+// See https://www.elastic.co/guide/en/elasticsearch/reference/6.1/search-aggregations-bucket-composite-aggregation.html
+// for details.
+func (a Aggregations) Composite(name string) (*AggregationBucketCompositeItems, bool) {
+	if raw, found := a[name]; found {
+		agg := new(AggregationBucketCompositeItems)
+		if raw == nil {
+			return agg, true
+		}
+		if err := json.Unmarshal(*raw, agg); err == nil {
+			return agg, true
+		}
+	}
+	return nil, false
+}
+
+// Path returns the raw result of a (sub-)aggregation by walking a
+// ">"-delimited path of aggregation names, e.g. "sales>byCategory>avgPrice".
+// Single-bucket aggregations like filter, global, nested, or children
+// embed their sub-aggregations directly and can be stepped through by
+// name. To step into a multi-bucket aggregation, pick one of its buckets
+// by key, as in Elasticsearch's buckets_path syntax, e.g.
+// "byDate['2017-01-01']>byCategory['shoes']>avgPrice". The key is compared
+// with both the key and the key_as_string of a bucket. Keyed buckets, e.g.
+// of a filters aggregation, are picked by their name.
+//
+// The second return value is false if any of the aggregations or buckets
+// along the path cannot be found, or if a multi-bucket aggregation is
+// stepped through without picking a bucket.
+func (a Aggregations) Path(path string) (json.RawMessage, bool) {
+	if path == "" {
+		return nil, false
+	}
+	names := strings.Split(path, ">")
+	aggs := a
+	for i, name := range names {
+		var key string
+		var hasKey bool
+		if n := strings.Index(name, "["); n > 0 && strings.HasSuffix(name, "]") {
+			key = strings.Trim(name[n+1:len(name)-1], `'"`)
+			name, hasKey = name[:n], true
+		}
+		raw, found := aggs[name]
+		if !found || raw == nil {
+			return nil, false
+		}
+		if hasKey {
+			bucket, found := pathBucket(*raw, key)
+			if !found {
+				return nil, false
+			}
+			raw = &bucket
+		}
+		if i == len(names)-1 {
+			return *raw, true
+		}
+		aggs = nil
+		if err := json.Unmarshal(*raw, &aggs); err != nil {
+			return nil, false
+		}
+		if _, multi := aggs["buckets"]; multi && !hasKey {
+			return nil, false
+		}
+	}
+	return nil, false
+}
+
+// pathBucket returns the bucket with the given key from the raw result
+// of a multi-bucket aggregation. Buckets can either be a list or, for
+// keyed aggregations, an object that maps the key to the bucket.
+func pathBucket(raw json.RawMessage, key string) (json.RawMessage, bool) {
+	var agg struct {
+		Buckets json.RawMessage `json:"buckets"`
+	}
+	if err := json.Unmarshal(raw, &agg); err != nil || len(agg.Buckets) == 0 {
+		return nil, false
+	}
+	var keyed map[string]json.RawMessage
+	if err := json.Unmarshal(agg.Buckets, &keyed); err == nil {
+		bucket, found := keyed[key]
+		return bucket, found
+	}
+	var buckets []json.RawMessage
+	if err := json.Unmarshal(agg.Buckets, &buckets); err != nil {
+		return nil, false
+	}
+	for _, bucket := range buckets {
+		var b struct {
+			Key         json.RawMessage `json:"key"`
+			KeyAsString *string         `json:"key_as_string"`
+		}
+		if err := json.Unmarshal(bucket, &b); err != nil {
+			continue
+		}
+		if b.KeyAsString != nil && *b.KeyAsString == key {
+			return bucket, true
+		}
+		var k string
+		if err := json.Unmarshal(b.Key, &k); err != nil {
+			k = string(b.Key) // numeric key
+		}
+		if k == key {
+			return bucket, true
+		}
+	}
+	return nil, false
+}
+
 // -- Single value metric --
 
 // AggregationValueMetric is a single-value metric, returned e.g. by a
@@ -757,6 +1023,142 @@ func (a *AggregationPercentilesMetric) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// -- Boxplot metric --
+
+// AggregationBoxplotMetric is a multi-value metric, returned by a Boxplot aggregation.
+type AggregationBoxplotMetric struct {
+	Aggregations
+
+	Min   *float64               // `json:"min,omitempty"`
+	Max   *float64               // `json:"max,omitempty"`
+	Q1    *float64               // `json:"q1,omitempty"`
+	Q2    *float64               // `json:"q2,omitempty"`
+	Q3    *float64               // `json:"q3,omitempty"`
+	Lower *float64               // `json:"lower,omitempty"`
+	Upper *float64               // `json:"upper,omitempty"`
+	Meta  map[string]interface{} // `json:"meta,omitempty"`
+}
+
+// UnmarshalJSON decodes JSON data and initializes an AggregationBoxplotMetric structure.
+func (a *AggregationBoxplotMetric) UnmarshalJSON(data []byte) error {
+	var aggs map[string]*json.RawMessage
+	if err := json.Unmarshal(data, &aggs); err != nil {
+		return err
+	}
+	if v, ok := aggs["min"]; ok && v != nil {
+		json.Unmarshal(*v, &a.Min)
+	}
+	if v, ok := aggs["max"]; ok && v != nil {
+		json.Unmarshal(*v, &a.Max)
+	}
+	if v, ok := aggs["q1"]; ok && v != nil {
+		json.Unmarshal(*v, &a.Q1)
+	}
+	if v, ok := aggs["q2"]; ok && v != nil {
+		json.Unmarshal(*v, &a.Q2)
+	}
+	if v, ok := aggs["q3"]; ok && v != nil {
+		json.Unmarshal(*v, &a.Q3)
+	}
+	if v, ok := aggs["lower"]; ok && v != nil {
+		json.Unmarshal(*v, &a.Lower)
+	}
+	if v, ok := aggs["upper"]; ok && v != nil {
+		json.Unmarshal(*v, &a.Upper)
+	}
+	if v, ok := aggs["meta"]; ok && v != nil {
+		json.Unmarshal(*v, &a.Meta)
+	}
+	a.Aggregations = aggs
+	return nil
+}
+
+// -- String stats metric --
+
+// AggregationStringStatsMetric is a multi-value metric, returned by a StringStats aggregation.
+type AggregationStringStatsMetric struct {
+	Aggregations
+
+	Count        int64                  // `json:"count"`
+	MinLength    *int64                 // `json:"min_length,omitempty"`
+	MaxLength    *int64                 // `json:"max_length,omitempty"`
+	AvgLength    *float64               // `json:"avg_length,omitempty"`
+	Entropy      *float64               // `json:"entropy,omitempty"`
+	Distribution map[string]float64     // `json:"distribution,omitempty"`
+	Meta         map[string]interface{} // `json:"meta,omitempty"`
+}
+
+// UnmarshalJSON decodes JSON data and initializes an AggregationStringStatsMetric structure.
+func (a *AggregationStringStatsMetric) UnmarshalJSON(data []byte) error {
+	var aggs map[string]*json.RawMessage
+	if err := json.Unmarshal(data, &aggs); err != nil {
+		return err
+	}
+	if v, ok := aggs["count"]; ok && v != nil {
+		json.Unmarshal(*v, &a.Count)
+	}
+	if v, ok := aggs["min_length"]; ok && v != nil {
+		json.Unmarshal(*v, &a.MinLength)
+	}
+	if v, ok := aggs["max_length"]; ok && v != nil {
+		json.Unmarshal(*v, &a.MaxLength)
+	}
+	if v, ok := aggs["avg_length"]; ok && v != nil {
+		json.Unmarshal(*v, &a.AvgLength)
+	}
+	if v, ok := aggs["entropy"]; ok && v != nil {
+		json.Unmarshal(*v, &a.Entropy)
+	}
+	if v, ok := aggs["distribution"]; ok && v != nil {
+		json.Unmarshal(*v, &a.Distribution)
+	}
+	if v, ok := aggs["meta"]; ok && v != nil {
+		json.Unmarshal(*v, &a.Meta)
+	}
+	a.Aggregations = aggs
+	return nil
+}
+
+// -- Scripted metric --
+
+// AggregationScriptedMetric is the result of a ScriptedMetric aggregation.
+// As the value is computed by the scripts, it can be any JSON value. Use
+// DecodeValue to decode it into a type of your choice.
+type AggregationScriptedMetric struct {
+	Aggregations
+
+	Value interface{}            //`json:"value"`
+	Meta  map[string]interface{} // `json:"meta,omitempty"`
+
+	rawValue json.RawMessage
+}
+
+// UnmarshalJSON decodes JSON data and initializes an AggregationScriptedMetric structure.
+func (a *AggregationScriptedMetric) UnmarshalJSON(data []byte) error {
+	var aggs map[string]*json.RawMessage
+	if err := json.Unmarshal(data, &aggs); err != nil {
+		return err
+	}
+	if v, ok := aggs["value"]; ok && v != nil {
+		a.rawValue = *v
+		json.Unmarshal(*v, &a.Value)
+	}
+	if v, ok := aggs["meta"]; ok && v != nil {
+		json.Unmarshal(*v, &a.Meta)
+	}
+	a.Aggregations = aggs
+	return nil
+}
+
+// DecodeValue decodes the value returned by the scripts into v.
+// It is a no-op if no value was returned.
+func (a *AggregationScriptedMetric) DecodeValue(v interface{}) error {
+	if len(a.rawValue) == 0 {
+		return nil
+	}
+	return json.Unmarshal(a.rawValue, v)
+}
+
 // -- Top-hits metric --
 
 // AggregationTopHitsMetric is a metric returned by a TopHits aggregation.
@@ -820,6 +1222,48 @@ func (a *AggregationGeoBoundsMetric) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// -- Geo-line metric --
+
+// AggregationGeoLineMetric is a metric as returned by a GeoLine aggregation.
+// It is a GeoJSON Feature with a LineString geometry.
+type AggregationGeoLineMetric struct {
+	Aggregations
+
+	Type     string `json:"type"` // "Feature"
+	Geometry struct {
+		Type        string      `json:"type"`        // "LineString"
+		Coordinates [][]float64 `json:"coordinates"` // list of [lon, lat] pairs
+	} `json:"geometry"`
+	Properties struct {
+		Complete   bool          `json:"complete"`
+		SortValues []interface{} `json:"sort_values,omitempty"`
+	} `json:"properties"`
+
+	Meta map[string]interface{} // `json:"meta,omitempty"`
+}
+
+// UnmarshalJSON decodes JSON data and initializes an AggregationGeoLineMetric structure.
+func (a *AggregationGeoLineMetric) UnmarshalJSON(data []byte) error {
+	var aggs map[string]*json.RawMessage
+	if err := json.Unmarshal(data, &aggs); err != nil {
+		return err
+	}
+	if v, ok := aggs["type"]; ok && v != nil {
+		json.Unmarshal(*v, &a.Type)
+	}
+	if v, ok := aggs["geometry"]; ok && v != nil {
+		json.Unmarshal(*v, &a.Geometry)
+	}
+	if v, ok := aggs["properties"]; ok && v != nil {
+		json.Unmarshal(*v, &a.Properties)
+	}
+	if v, ok := aggs["meta"]; ok && v != nil {
+		json.Unmarshal(*v, &a.Meta)
+	}
+	a.Aggregations = aggs
+	return nil
+}
+
 // -- Single bucket --
 
 // AggregationSingleBucket is a single bucket, returned e.g. via an aggregation of type Global.
@@ -939,13 +1383,25 @@ func (a *AggregationBucketRangeItem) UnmarshalJSON(data []byte) error {
 		json.Unmarshal(*v, &a.DocCount)
 	}
 	if v, ok := aggs["from"]; ok && v != nil {
-		json.Unmarshal(*v, &a.From)
+		// Numeric and date ranges return a number, IP ranges a string
+		var from *float64
+		if err := json.Unmarshal(*v, &from); err == nil {
+			a.From = from
+		} else {
+			json.Unmarshal(*v, &a.FromAsString)
+		}
 	}
 	if v, ok := aggs["from_as_string"]; ok && v != nil {
 		json.Unmarshal(*v, &a.FromAsString)
 	}
 	if v, ok := aggs["to"]; ok && v != nil {
-		json.Unmarshal(*v, &a.To)
+		// Numeric and date ranges return a number, IP ranges a string
+		var to *float64
+		if err := json.Unmarshal(*v, &to); err == nil {
+			a.To = to
+		} else {
+			json.Unmarshal(*v, &a.ToAsString)
+		}
 	}
 	if v, ok := aggs["to_as_string"]; ok && v != nil {
 		json.Unmarshal(*v, &a.ToAsString)
@@ -1087,7 +1543,9 @@ func (a *AggregationBucketSignificantTerm) UnmarshalJSON(data []byte) error {
 // -- Bucket filters --
 
 // AggregationBucketFilters is a multi-bucket aggregation that is returned
-// with a filters aggregation.
+// with a filters aggregation. If the other bucket is enabled, it is the
+// last entry of Buckets for unnamed filters, and the entry with the other
+// bucket key (default "_other_") of NamedBuckets for named filters.
 type AggregationBucketFilters struct {
 	Aggregations
 
@@ -1120,8 +1578,9 @@ func (a *AggregationBucketFilters) UnmarshalJSON(data []byte) error {
 type AggregationBucketHistogramItems struct {
 	Aggregations
 
-	Buckets []*AggregationBucketHistogramItem //`json:"buckets"`
-	Meta    map[string]interface{}            // `json:"meta,omitempty"`
+	Buckets  []*AggregationBucketHistogramItem //`json:"buckets"`
+	Interval string                            // `json:"interval,omitempty"`
+	Meta     map[string]interface{}            // `json:"meta,omitempty"`
 }
 
 // UnmarshalJSON decodes JSON data and initializes an AggregationBucketHistogramItems structure.
@@ -1133,6 +1592,9 @@ func (a *AggregationBucketHistogramItems) UnmarshalJSON(data []byte) error {
 	if v, ok := aggs["buckets"]; ok && v != nil {
 		json.Unmarshal(*v, &a.Buckets)
 	}
+	if v, ok := aggs["interval"]; ok && v != nil {
+		json.Unmarshal(*v, &a.Interval)
+	}
 	if v, ok := aggs["meta"]; ok && v != nil {
 		json.Unmarshal(*v, &a.Meta)
 	}
@@ -1168,6 +1630,68 @@ func (a *AggregationBucketHistogramItem) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// -- Bucket composite items --
+
+// AggregationBucketCompositeItems implements the response structure
+// for a bucket aggregation of type composite.
+type AggregationBucketCompositeItems struct {
+	Aggregations
+
+	Buckets  []*AggregationBucketCompositeItem //`json:"buckets"`
+	Meta     map[string]interface{}            // `json:"meta,omitempty"`
+	AfterKey map[string]interface{}            // `json:"after_key,omitempty"`
+}
+
+// UnmarshalJSON decodes JSON data and initializes an AggregationBucketCompositeItems structure.
+func (a *AggregationBucketCompositeItems) UnmarshalJSON(data []byte) error {
+	var aggs map[string]*json.RawMessage
+	if err := json.Unmarshal(data, &aggs); err != nil {
+		return err
+	}
+	if v, ok := aggs["buckets"]; ok && v != nil {
+		json.Unmarshal(*v, &a.Buckets)
+	}
+	if v, ok := aggs["meta"]; ok && v != nil {
+		json.Unmarshal(*v, &a.Meta)
+	}
+	if v, ok := aggs["after_key"]; ok && v != nil {
+		json.Unmarshal(*v, &a.AfterKey)
+	}
+	a.Aggregations = aggs
+	return nil
+}
+
+// AggregationBucketCompositeItem is a single bucket of an AggregationBucketCompositeItems structure.
+type AggregationBucketCompositeItem struct {
+	Aggregations
+
+	Key      map[string]interface{} //`json:"key"`
+	DocCount int64                  //`json:"doc_count"`
+}
+
+// CompositeBucket is an alias for AggregationBucketCompositeItem, used e.g.
+// by SearchService.EachCompositeBucket. It is an alias rather than a new
+// type, so buckets returned by Aggregations.Composite can be passed as is.
+type CompositeBucket = AggregationBucketCompositeItem
+
+// UnmarshalJSON decodes JSON data and initializes an AggregationBucketCompositeItem structure.
+func (a *AggregationBucketCompositeItem) UnmarshalJSON(data []byte) error {
+	var aggs map[string]*json.RawMessage
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	if err := dec.Decode(&aggs); err != nil {
+		return err
+	}
+	if v, ok := aggs["key"]; ok && v != nil {
+		json.Unmarshal(*v, &a.Key)
+	}
+	if v, ok := aggs["doc_count"]; ok && v != nil {
+		json.Unmarshal(*v, &a.DocCount)
+	}
+	a.Aggregations = aggs
+	return nil
+}
+
 // -- Pipeline simple value --
 
 // AggregationPipelineSimpleValue is a simple value, returned e.g. by a
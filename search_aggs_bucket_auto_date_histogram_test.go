@@ -0,0 +1,51 @@
+// Copyright 2012-present Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestAutoDateHistogramAggregation(t *testing.T) {
+	agg := NewAutoDateHistogramAggregation().Field("date").Buckets(10)
+	src, err := agg.Source()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(src)
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"auto_date_histogram":{"buckets":10,"field":"date"}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
+
+func TestAutoDateHistogramAggregationWithOptions(t *testing.T) {
+	agg := NewAutoDateHistogramAggregation().
+		Field("date").
+		Buckets(5).
+		MinimumInterval("minute").
+		Format("yyyy-MM-dd").
+		TimeZone("-01:00").
+		Missing("2000/01/01").
+		SubAggregation("total_sales", NewSumAggregation().Field("price"))
+	src, err := agg.Source()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(src)
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"aggregations":{"total_sales":{"sum":{"field":"price"}}},"auto_date_histogram":{"buckets":5,"field":"date","format":"yyyy-MM-dd","minimum_interval":"minute","missing":"2000/01/01","time_zone":"-01:00"}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
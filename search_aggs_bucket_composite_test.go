@@ -0,0 +1,224 @@
+// Copyright 2012-present Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"golang.org/x/net/context"
+)
+
+func TestCompositeAggregation(t *testing.T) {
+	agg := NewCompositeAggregation().
+		Sources(
+			NewCompositeAggregationTermsValuesSource("my_terms").Field("a_term").Desc(),
+			NewCompositeAggregationHistogramValuesSource("my_histogram", 5).Field("price").Asc(),
+			NewCompositeAggregationDateHistogramValuesSource("my_date_histogram", "1d").Field("purchase_date").Format("yyyy-MM-dd"),
+		).
+		Size(10).
+		AggregateAfter(map[string]interface{}{"my_terms": "1", "my_histogram": 2, "my_date_histogram": "3"})
+	src, err := agg.Source()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(src)
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"composite":{"after":{"my_date_histogram":"3","my_histogram":2,"my_terms":"1"},"size":10,"sources":[{"my_terms":{"terms":{"field":"a_term","order":"desc"}}},{"my_histogram":{"histogram":{"field":"price","interval":5,"order":"asc"}}},{"my_date_histogram":{"date_histogram":{"field":"purchase_date","format":"yyyy-MM-dd","interval":"1d"}}}]}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
+
+func TestCompositeAggregationWithoutSources(t *testing.T) {
+	agg := NewCompositeAggregation().Size(10)
+	if _, err := agg.Source(); err == nil {
+		t.Fatal("expected error without sources")
+	}
+}
+
+func TestSearchEachCompositeBucket(t *testing.T) {
+	var (
+		mu     sync.Mutex
+		afters []interface{}
+	)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Aggregations map[string]struct {
+				Composite struct {
+					After map[string]interface{} `json:"after"`
+				} `json:"composite"`
+			} `json:"aggregations"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		after := body.Aggregations["by_user"].Composite.After
+
+		mu.Lock()
+		afters = append(afters, after["user"])
+		mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		switch after["user"] {
+		case nil:
+			w.Write([]byte(`{"aggregations":{"by_user":{"after_key":{"user":"bob"},"buckets":[
+				{"key":{"user":"alice"},"doc_count":3},
+				{"key":{"user":"bob"},"doc_count":2}
+			]}}}`))
+		case "bob":
+			w.Write([]byte(`{"aggregations":{"by_user":{"after_key":{"user":"carol"},"buckets":[
+				{"key":{"user":"carol"},"doc_count":1}
+			]}}}`))
+		default:
+			w.Write([]byte(`{"aggregations":{"by_user":{"buckets":[]}}}`))
+		}
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(SetURL(ts.URL), SetSniff(false), SetHealthcheck(false), SetMaxRetries(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	agg := NewCompositeAggregation().
+		Sources(NewCompositeAggregationTermsValuesSource("user").Field("user")).
+		Size(2)
+	var users []interface{}
+	var docs int64
+	err = client.Search().Index("twitter").Size(0).Aggregation("by_user", agg).
+		EachCompositeBucket(context.TODO(), func(bucket *AggregationBucketCompositeItem) error {
+			users = append(users, bucket.Key["user"])
+			docs += bucket.DocCount
+			return nil
+		})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, have := 3, len(users); want != have {
+		t.Fatalf("expected %d buckets; got: %d (%v)", want, have, users)
+	}
+	for i, want := range []string{"alice", "bob", "carol"} {
+		if users[i] != want {
+			t.Errorf("bucket #%d: expected user %q; got: %v", i, want, users[i])
+		}
+	}
+	if want, have := int64(6), docs; want != have {
+		t.Errorf("expected %d docs; got: %d", want, have)
+	}
+
+	mu.Lock()
+	requests := afters
+	mu.Unlock()
+	expected := []interface{}{nil, "bob", "carol"}
+	if want, have := len(expected), len(requests); want != have {
+		t.Fatalf("expected %d requests; got: %d (%v)", want, have, requests)
+	}
+	for i := range expected {
+		if expected[i] != requests[i] {
+			t.Errorf("request #%d: expected after %v; got: %v", i+1, expected[i], requests[i])
+		}
+	}
+
+	// Callback errors stop the iteration
+	errStop := errors.New("stop")
+	calls := 0
+	err = client.Search().Index("twitter").Size(0).
+		Aggregation("by_user", NewCompositeAggregation().Sources(NewCompositeAggregationTermsValuesSource("user").Field("user"))).
+		EachCompositeBucket(context.TODO(), func(bucket *AggregationBucketCompositeItem) error {
+			calls++
+			return errStop
+		})
+	if err != errStop {
+		t.Fatalf("expected error %v; got: %v", errStop, err)
+	}
+	if calls != 1 {
+		t.Errorf("expected callback to be called %d times; got: %d", 1, calls)
+	}
+}
+
+func TestSearchEachCompositeBucketWithoutAfterKey(t *testing.T) {
+	var (
+		mu     sync.Mutex
+		afters []interface{}
+	)
+	// Elasticsearch before 6.3 does not return an after_key
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Aggregations map[string]struct {
+				Composite struct {
+					After map[string]interface{} `json:"after"`
+				} `json:"composite"`
+			} `json:"aggregations"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		after := body.Aggregations["by_user"].Composite.After
+
+		mu.Lock()
+		afters = append(afters, after["user"])
+		mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		switch after["user"] {
+		case nil:
+			w.Write([]byte(`{"aggregations":{"by_user":{"buckets":[
+				{"key":{"user":"alice"},"doc_count":3},
+				{"key":{"user":"bob"},"doc_count":2}
+			]}}}`))
+		case "bob":
+			w.Write([]byte(`{"aggregations":{"by_user":{"buckets":[
+				{"key":{"user":"carol"},"doc_count":1}
+			]}}}`))
+		default:
+			w.Write([]byte(`{"aggregations":{"by_user":{"buckets":[]}}}`))
+		}
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(SetURL(ts.URL), SetSniff(false), SetHealthcheck(false), SetMaxRetries(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	agg := NewCompositeAggregation().
+		Sources(NewCompositeAggregationTermsValuesSource("user").Field("user")).
+		Size(2)
+	var users []interface{}
+	err = client.Search().Index("twitter").Size(0).Aggregation("by_user", agg).
+		EachCompositeBucket(context.TODO(), func(bucket *CompositeBucket) error {
+			users = append(users, bucket.Key["user"])
+			return nil
+		})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, have := 3, len(users); want != have {
+		t.Fatalf("expected %d buckets; got: %d (%v)", want, have, users)
+	}
+
+	mu.Lock()
+	requests := afters
+	mu.Unlock()
+	expected := []interface{}{nil, "bob", "carol"}
+	if want, have := len(expected), len(requests); want != have {
+		t.Fatalf("expected %d requests; got: %d (%v)", want, have, requests)
+	}
+	for i := range expected {
+		if expected[i] != requests[i] {
+			t.Errorf("request #%d: expected after %v; got: %v", i+1, expected[i], requests[i])
+		}
+	}
+}
@@ -20,6 +20,8 @@ type DateHistogramAggregation struct {
 	minDocCount       *int64
 	extendedBoundsMin interface{}
 	extendedBoundsMax interface{}
+	hardBoundsMin     interface{}
+	hardBoundsMax     interface{}
 	timeZone          string
 	format            string
 	offset            string
@@ -196,6 +198,27 @@ func (a *DateHistogramAggregation) ExtendedBoundsMax(max interface{}) *DateHisto
 	return a
 }
 
+// HardBounds accepts int, int64, string, or time.Time values.
+// Buckets are only created within [min, max], regardless of the
+// documents matched.
+func (a *DateHistogramAggregation) HardBounds(min, max interface{}) *DateHistogramAggregation {
+	a.hardBoundsMin = min
+	a.hardBoundsMax = max
+	return a
+}
+
+// HardBoundsMin accepts int, int64, string, or time.Time values.
+func (a *DateHistogramAggregation) HardBoundsMin(min interface{}) *DateHistogramAggregation {
+	a.hardBoundsMin = min
+	return a
+}
+
+// HardBoundsMax accepts int, int64, string, or time.Time values.
+func (a *DateHistogramAggregation) HardBoundsMax(max interface{}) *DateHistogramAggregation {
+	a.hardBoundsMax = max
+	return a
+}
+
 func (a *DateHistogramAggregation) Source() (interface{}, error) {
 	// Example:
 	// {
@@ -262,6 +285,16 @@ func (a *DateHistogramAggregation) Source() (interface{}, error) {
 		}
 		opts["extended_bounds"] = bounds
 	}
+	if a.hardBoundsMin != nil || a.hardBoundsMax != nil {
+		bounds := make(map[string]interface{})
+		if a.hardBoundsMin != nil {
+			bounds["min"] = a.hardBoundsMin
+		}
+		if a.hardBoundsMax != nil {
+			bounds["max"] = a.hardBoundsMax
+		}
+		opts["hard_bounds"] = bounds
+	}
 
 	// AggregationBuilder (SubAggregations)
 	if len(a.subAggregations) > 0 {
@@ -7,6 +7,7 @@ package elastic
 import (
 	"encoding/json"
 	"testing"
+	"time"
 )
 
 func TestDateHistogramAggregation(t *testing.T) {
@@ -47,3 +48,26 @@ func TestDateHistogramAggregationWithMissing(t *testing.T) {
 		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
 	}
 }
+
+func TestDateHistogramAggregationWithBounds(t *testing.T) {
+	agg := NewDateHistogramAggregation().Field("date").Interval("day").
+		MinDocCount(0).
+		ExtendedBounds("2017-01-01", "2017-01-31").
+		HardBounds(
+			time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC),
+			time.Date(2017, 1, 15, 0, 0, 0, 0, time.UTC),
+		)
+	src, err := agg.Source()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(src)
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"date_histogram":{"extended_bounds":{"max":"2017-01-31","min":"2017-01-01"},"field":"date","hard_bounds":{"max":"2017-01-15T00:00:00Z","min":"2017-01-01T00:00:00Z"},"interval":"day","min_doc_count":0}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
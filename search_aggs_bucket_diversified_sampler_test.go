@@ -0,0 +1,53 @@
+// Copyright 2012-present Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestDiversifiedSamplerAggregation(t *testing.T) {
+	keywordsAgg := NewSignificantTermsAggregation().Field("tags")
+	agg := NewDiversifiedSamplerAggregation().
+		Field("author").
+		ShardSize(200).
+		MaxDocsPerValue(3).
+		ExecutionHint("map").
+		SubAggregation("keywords", keywordsAgg)
+	src, err := agg.Source()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(src)
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"aggregations":{"keywords":{"significant_terms":{"field":"tags"}}},"diversified_sampler":{"execution_hint":"map","field":"author","max_docs_per_value":3,"shard_size":200}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
+
+func TestDiversifiedSamplerAggregationWithScript(t *testing.T) {
+	agg := NewDiversifiedSamplerAggregation().
+		Script(NewScript("doc['tags'].hashCode()").Lang("painless")).
+		ShardSize(200).
+		SubAggregation("keywords", NewSignificantTermsAggregation().Field("tags"))
+	src, err := agg.Source()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(src)
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"aggregations":{"keywords":{"significant_terms":{"field":"tags"}}},"diversified_sampler":{"script":{"inline":"doc['tags'].hashCode()","lang":"painless"},"shard_size":200}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
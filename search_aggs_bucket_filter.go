@@ -4,6 +4,8 @@
 
 package elastic
 
+import "errors"
+
 // FilterAggregation defines a single bucket of all the documents
 // in the current document set context that match a specified filter.
 // Often this will be used to narrow down the current aggregation context
@@ -21,6 +23,8 @@ func NewFilterAggregation() *FilterAggregation {
 	}
 }
 
+// SubAggregation adds a sub-aggregation which is scoped to the documents
+// matching the filter.
 func (a *FilterAggregation) SubAggregation(name string, subAggregation Aggregation) *FilterAggregation {
 	a.subAggregations[name] = subAggregation
 	return a
@@ -48,6 +52,9 @@ func (a *FilterAggregation) Source() (interface{}, error) {
 	//	}
 	// This method returns only the { "filter" : {} } part.
 
+	if a.filter == nil {
+		return nil, errors.New("elastic: Filter is required in FilterAggregation")
+	}
 	src, err := a.filter.Source()
 	if err != nil {
 		return nil, err
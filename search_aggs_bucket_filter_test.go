@@ -47,6 +47,33 @@ func TestFilterAggregationWithSubAggregation(t *testing.T) {
 	}
 }
 
+func TestFilterAggregationWithTermFilterAndAvgSubAggregation(t *testing.T) {
+	avgPriceAgg := NewAvgAggregation().Field("price")
+	filter := NewTermQuery("type", "t-shirt")
+	agg := NewFilterAggregation().Filter(filter).
+		SubAggregation("avg_price", avgPriceAgg)
+	src, err := agg.Source()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(src)
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"aggregations":{"avg_price":{"avg":{"field":"price"}}},"filter":{"term":{"type":"t-shirt"}}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
+
+func TestFilterAggregationWithoutFilter(t *testing.T) {
+	agg := NewFilterAggregation()
+	if _, err := agg.Source(); err == nil {
+		t.Fatal("expected error when no filter is given")
+	}
+}
+
 func TestFilterAggregationWithMeta(t *testing.T) {
 	filter := NewRangeQuery("stock").Gt(0)
 	agg := NewFilterAggregation().Filter(filter).Meta(map[string]interface{}{"name": "Oliver"})
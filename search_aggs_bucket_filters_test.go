@@ -49,6 +49,45 @@ func TestFiltersAggregationFilterWithName(t *testing.T) {
 	}
 }
 
+func TestFiltersAggregationWithOtherBucket(t *testing.T) {
+	agg := NewFiltersAggregation().
+		FilterWithName("errors", NewMatchQuery("body", "error")).
+		FilterWithName("warnings", NewMatchQuery("body", "warning")).
+		OtherBucketKey("other_messages")
+	src, err := agg.Source()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(src)
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"filters":{"filters":{"errors":{"match":{"body":{"query":"error"}}},"warnings":{"match":{"body":{"query":"warning"}}}},"other_bucket_key":"other_messages"}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
+
+func TestFiltersAggregationWithUnnamedOtherBucket(t *testing.T) {
+	agg := NewFiltersAggregation().
+		Filters(NewTermQuery("symbol", "MSFT"), NewTermQuery("symbol", "GOOG")).
+		OtherBucket(true)
+	src, err := agg.Source()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(src)
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"filters":{"filters":[{"term":{"symbol":"MSFT"}},{"term":{"symbol":"GOOG"}}],"other_bucket":true}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
+
 func TestFiltersAggregationWithKeyedAndNonKeyedFilters(t *testing.T) {
 	agg := NewFiltersAggregation().
 		Filter(NewTermQuery("symbol", "MSFT")).               // unnamed
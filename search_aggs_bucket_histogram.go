@@ -20,8 +20,10 @@ type HistogramAggregation struct {
 	order             string
 	orderAsc          bool
 	minDocCount       *int64
-	extendedBoundsMin *int64
-	extendedBoundsMax *int64
+	extendedBoundsMin *float64
+	extendedBoundsMax *float64
+	hardBoundsMin     *float64
+	hardBoundsMax     *float64
 	offset            *int64
 }
 
@@ -149,22 +151,43 @@ func (a *HistogramAggregation) MinDocCount(minDocCount int64) *HistogramAggregat
 	return a
 }
 
-func (a *HistogramAggregation) ExtendedBounds(min, max int64) *HistogramAggregation {
+// ExtendedBounds forces the histogram to start building buckets at min
+// and keep building them up to max, even if there are no documents
+// in that range.
+func (a *HistogramAggregation) ExtendedBounds(min, max float64) *HistogramAggregation {
 	a.extendedBoundsMin = &min
 	a.extendedBoundsMax = &max
 	return a
 }
 
-func (a *HistogramAggregation) ExtendedBoundsMin(min int64) *HistogramAggregation {
+func (a *HistogramAggregation) ExtendedBoundsMin(min float64) *HistogramAggregation {
 	a.extendedBoundsMin = &min
 	return a
 }
 
-func (a *HistogramAggregation) ExtendedBoundsMax(max int64) *HistogramAggregation {
+func (a *HistogramAggregation) ExtendedBoundsMax(max float64) *HistogramAggregation {
 	a.extendedBoundsMax = &max
 	return a
 }
 
+// HardBounds limits the range of buckets in the histogram to [min, max].
+// Buckets outside of that range are never created.
+func (a *HistogramAggregation) HardBounds(min, max float64) *HistogramAggregation {
+	a.hardBoundsMin = &min
+	a.hardBoundsMax = &max
+	return a
+}
+
+func (a *HistogramAggregation) HardBoundsMin(min float64) *HistogramAggregation {
+	a.hardBoundsMin = &min
+	return a
+}
+
+func (a *HistogramAggregation) HardBoundsMax(max float64) *HistogramAggregation {
+	a.hardBoundsMax = &max
+	return a
+}
+
 func (a *HistogramAggregation) Offset(offset int64) *HistogramAggregation {
 	a.offset = &offset
 	return a
@@ -230,6 +253,16 @@ func (a *HistogramAggregation) Source() (interface{}, error) {
 		}
 		opts["extended_bounds"] = bounds
 	}
+	if a.hardBoundsMin != nil || a.hardBoundsMax != nil {
+		bounds := make(map[string]interface{})
+		if a.hardBoundsMin != nil {
+			bounds["min"] = a.hardBoundsMin
+		}
+		if a.hardBoundsMax != nil {
+			bounds["max"] = a.hardBoundsMax
+		}
+		opts["hard_bounds"] = bounds
+	}
 
 	// AggregationBuilder (SubAggregations)
 	if len(a.subAggregations) > 0 {
@@ -59,3 +59,23 @@ func TestHistogramAggregationWithMissing(t *testing.T) {
 		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
 	}
 }
+
+func TestHistogramAggregationWithBounds(t *testing.T) {
+	agg := NewHistogramAggregation().Field("price").Interval(50).
+		MinDocCount(0).
+		ExtendedBounds(0, 500).
+		HardBounds(0, 250.5)
+	src, err := agg.Source()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(src)
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"histogram":{"extended_bounds":{"max":500,"min":0},"field":"price","hard_bounds":{"max":250.5,"min":0},"interval":50,"min_doc_count":0}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
@@ -0,0 +1,174 @@
+// Copyright 2012-present Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+// IpRangeAggregation is a range aggregation that is dedicated for
+// IP addresses. Ranges can be specified by from and to addresses or
+// by CIDR masks. Note that this aggregation includes the from value
+// and excludes the to value for each range.
+// See: https://www.elastic.co/guide/en/elasticsearch/reference/current/search-aggregations-bucket-iprange-aggregation.html
+type IpRangeAggregation struct {
+	field           string
+	subAggregations map[string]Aggregation
+	meta            map[string]interface{}
+	keyed           *bool
+	entries         []IpRangeAggregationEntry
+}
+
+// IpRangeAggregationEntry is a single range of an IpRangeAggregation.
+// Either Mask or From and/or To should be set.
+type IpRangeAggregationEntry struct {
+	Key  string
+	Mask string
+	From string
+	To   string
+}
+
+// NewIpRangeAggregation creates and initializes a new IpRangeAggregation.
+func NewIpRangeAggregation() *IpRangeAggregation {
+	return &IpRangeAggregation{
+		subAggregations: make(map[string]Aggregation),
+		entries:         make([]IpRangeAggregationEntry, 0),
+	}
+}
+
+// Field is the name of the IP field to aggregate on.
+func (a *IpRangeAggregation) Field(field string) *IpRangeAggregation {
+	a.field = field
+	return a
+}
+
+func (a *IpRangeAggregation) SubAggregation(name string, subAggregation Aggregation) *IpRangeAggregation {
+	a.subAggregations[name] = subAggregation
+	return a
+}
+
+// Meta sets the meta data to be included in the aggregation response.
+func (a *IpRangeAggregation) Meta(metaData map[string]interface{}) *IpRangeAggregation {
+	a.meta = metaData
+	return a
+}
+
+func (a *IpRangeAggregation) Keyed(keyed bool) *IpRangeAggregation {
+	a.keyed = &keyed
+	return a
+}
+
+// AddMaskRange adds a range specified by a CIDR mask, e.g. "10.0.0.0/25".
+func (a *IpRangeAggregation) AddMaskRange(mask string) *IpRangeAggregation {
+	a.entries = append(a.entries, IpRangeAggregationEntry{Mask: mask})
+	return a
+}
+
+// AddMaskRangeWithKey adds a range specified by a CIDR mask and
+// returns its bucket under the given key.
+func (a *IpRangeAggregation) AddMaskRangeWithKey(key, mask string) *IpRangeAggregation {
+	a.entries = append(a.entries, IpRangeAggregationEntry{Key: key, Mask: mask})
+	return a
+}
+
+// AddRange adds a range from one IP address (inclusive) to another
+// (exclusive). Pass an empty string for an unbounded side.
+func (a *IpRangeAggregation) AddRange(from, to string) *IpRangeAggregation {
+	a.entries = append(a.entries, IpRangeAggregationEntry{From: from, To: to})
+	return a
+}
+
+func (a *IpRangeAggregation) AddRangeWithKey(key, from, to string) *IpRangeAggregation {
+	a.entries = append(a.entries, IpRangeAggregationEntry{Key: key, From: from, To: to})
+	return a
+}
+
+func (a *IpRangeAggregation) AddUnboundedTo(from string) *IpRangeAggregation {
+	a.entries = append(a.entries, IpRangeAggregationEntry{From: from})
+	return a
+}
+
+func (a *IpRangeAggregation) AddUnboundedToWithKey(key, from string) *IpRangeAggregation {
+	a.entries = append(a.entries, IpRangeAggregationEntry{Key: key, From: from})
+	return a
+}
+
+func (a *IpRangeAggregation) AddUnboundedFrom(to string) *IpRangeAggregation {
+	a.entries = append(a.entries, IpRangeAggregationEntry{To: to})
+	return a
+}
+
+func (a *IpRangeAggregation) AddUnboundedFromWithKey(key, to string) *IpRangeAggregation {
+	a.entries = append(a.entries, IpRangeAggregationEntry{Key: key, To: to})
+	return a
+}
+
+func (a *IpRangeAggregation) Source() (interface{}, error) {
+	// Example:
+	// {
+	//     "aggs" : {
+	//         "ip_ranges" : {
+	//             "ip_range" : {
+	//                 "field" : "ip",
+	//                 "ranges" : [
+	//                     { "mask" : "10.0.0.0/25" },
+	//                     { "from" : "10.0.0.127", "to" : "10.0.0.200" }
+	//                 ]
+	//             }
+	//         }
+	//     }
+	// }
+	//
+	// This method returns only the { "ip_range" : { ... } } part.
+
+	source := make(map[string]interface{})
+	opts := make(map[string]interface{})
+	source["ip_range"] = opts
+
+	// ValuesSourceAggregationBuilder
+	if a.field != "" {
+		opts["field"] = a.field
+	}
+
+	if a.keyed != nil {
+		opts["keyed"] = *a.keyed
+	}
+
+	var ranges []interface{}
+	for _, ent := range a.entries {
+		r := make(map[string]interface{})
+		if ent.Key != "" {
+			r["key"] = ent.Key
+		}
+		if ent.Mask != "" {
+			r["mask"] = ent.Mask
+		} else {
+			if ent.From != "" {
+				r["from"] = ent.From
+			}
+			if ent.To != "" {
+				r["to"] = ent.To
+			}
+		}
+		ranges = append(ranges, r)
+	}
+	opts["ranges"] = ranges
+
+	// AggregationBuilder (SubAggregations)
+	if len(a.subAggregations) > 0 {
+		aggsMap := make(map[string]interface{})
+		source["aggregations"] = aggsMap
+		for name, aggregate := range a.subAggregations {
+			src, err := aggregate.Source()
+			if err != nil {
+				return nil, err
+			}
+			aggsMap[name] = src
+		}
+	}
+
+	// Add Meta data if available
+	if len(a.meta) > 0 {
+		source["meta"] = a.meta
+	}
+
+	return source, nil
+}
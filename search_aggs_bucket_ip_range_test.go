@@ -0,0 +1,70 @@
+// Copyright 2012-present Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestIpRangeAggregation(t *testing.T) {
+	agg := NewIpRangeAggregation().Field("ip")
+	agg = agg.AddUnboundedFrom("10.0.0.5")
+	agg = agg.AddRange("10.0.0.5", "10.0.0.127")
+	agg = agg.AddUnboundedTo("10.0.0.127")
+	src, err := agg.Source()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(src)
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"ip_range":{"field":"ip","ranges":[{"to":"10.0.0.5"},{"from":"10.0.0.5","to":"10.0.0.127"},{"from":"10.0.0.127"}]}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
+
+func TestIpRangeAggregationWithMaskRanges(t *testing.T) {
+	agg := NewIpRangeAggregation().Field("ip").Keyed(true)
+	agg = agg.AddMaskRange("10.0.0.0/25")
+	agg = agg.AddMaskRangeWithKey("upper", "10.0.0.127/25")
+	agg = agg.AddMaskRange("2001:db8::/32")
+	src, err := agg.Source()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(src)
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"ip_range":{"field":"ip","keyed":true,"ranges":[{"mask":"10.0.0.0/25"},{"key":"upper","mask":"10.0.0.127/25"},{"mask":"2001:db8::/32"}]}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
+
+func TestIpRangeAggregationWithKeyAndSubAggregation(t *testing.T) {
+	agg := NewIpRangeAggregation().Field("ip").
+		AddRangeWithKey("internal", "10.0.0.0", "10.255.255.255").
+		SubAggregation("hosts", NewTermsAggregation().Field("host")).
+		Meta(map[string]interface{}{"name": "Oliver"})
+	src, err := agg.Source()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(src)
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"aggregations":{"hosts":{"terms":{"field":"host"}}},"ip_range":{"field":"ip","ranges":[{"from":"10.0.0.0","key":"internal","to":"10.255.255.255"}]},"meta":{"name":"Oliver"}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
@@ -0,0 +1,79 @@
+// Copyright 2012-present Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+// ParentAggregation is a special single bucket aggregation that selects
+// parent documents that have the specified type, as defined in a join field.
+// It is the counterpart of ChildrenAggregation.
+// See: https://www.elastic.co/guide/en/elasticsearch/reference/current/search-aggregations-bucket-parent-aggregation.html
+type ParentAggregation struct {
+	typ             string
+	subAggregations map[string]Aggregation
+	meta            map[string]interface{}
+}
+
+// NewParentAggregation creates a new ParentAggregation.
+func NewParentAggregation() *ParentAggregation {
+	return &ParentAggregation{
+		subAggregations: make(map[string]Aggregation),
+	}
+}
+
+// Type is the child type of the join field. The aggregation selects the
+// parents of the documents of this type.
+func (a *ParentAggregation) Type(typ string) *ParentAggregation {
+	a.typ = typ
+	return a
+}
+
+func (a *ParentAggregation) SubAggregation(name string, subAggregation Aggregation) *ParentAggregation {
+	a.subAggregations[name] = subAggregation
+	return a
+}
+
+// Meta sets the meta data to be included in the aggregation response.
+func (a *ParentAggregation) Meta(metaData map[string]interface{}) *ParentAggregation {
+	a.meta = metaData
+	return a
+}
+
+func (a *ParentAggregation) Source() (interface{}, error) {
+	// Example:
+	//	{
+	//    "aggs" : {
+	//      "to-questions" : {
+	//        "parent": {
+	//          "type" : "answer"
+	//        }
+	//      }
+	//    }
+	//	}
+	// This method returns only the { "type" : ... } part.
+
+	source := make(map[string]interface{})
+	opts := make(map[string]interface{})
+	source["parent"] = opts
+	opts["type"] = a.typ
+
+	// AggregationBuilder (SubAggregations)
+	if len(a.subAggregations) > 0 {
+		aggsMap := make(map[string]interface{})
+		source["aggregations"] = aggsMap
+		for name, aggregate := range a.subAggregations {
+			src, err := aggregate.Source()
+			if err != nil {
+				return nil, err
+			}
+			aggsMap[name] = src
+		}
+	}
+
+	// Add Meta data if available
+	if len(a.meta) > 0 {
+		source["meta"] = a.meta
+	}
+
+	return source, nil
+}
@@ -4,6 +4,8 @@
 
 package elastic
 
+import "sort"
+
 // TermsAggregation is a multi-bucket value source based aggregation
 // where buckets are dynamically built - one per unique value.
 // See: http://www.elasticsearch.org/guide/en/elasticsearch/reference/current/search-aggregations-bucket-terms-aggregation.html
@@ -83,6 +85,15 @@ func (a *TermsAggregation) ShardSize(shardSize int) *TermsAggregation {
 	return a
 }
 
+// MinDocCount returns only terms that match at least the given number
+// of documents (default: 1). Setting it to 0 also returns buckets for
+// terms without any matching documents.
+//
+// Notice that with a value of 0, terms are collected from all documents
+// in the shards, not only the ones matching the query. Zero-count buckets
+// are only returned for terms that exist in the index, and they may
+// include terms of deleted documents or other types. Sorting by
+// ascending count is inaccurate in that case.
 func (a *TermsAggregation) MinDocCount(minDocCount int) *TermsAggregation {
 	a.minDocCount = &minDocCount
 	return a
@@ -157,6 +168,23 @@ func (a *TermsAggregation) OrderByTermDesc() *TermsAggregation {
 	return a.OrderByTerm(false)
 }
 
+// OrderByKey sorts buckets by their key. It is the replacement of
+// OrderByTerm in Elasticsearch 6.0 or later.
+func (a *TermsAggregation) OrderByKey(asc bool) *TermsAggregation {
+	// "order" : { "_key" : "asc" }
+	a.order = "_key"
+	a.orderAsc = asc
+	return a
+}
+
+func (a *TermsAggregation) OrderByKeyAsc() *TermsAggregation {
+	return a.OrderByKey(true)
+}
+
+func (a *TermsAggregation) OrderByKeyDesc() *TermsAggregation {
+	return a.OrderByKey(false)
+}
+
 // OrderByAggregation creates a bucket ordering strategy which sorts buckets
 // based on a single-valued calc get.
 func (a *TermsAggregation) OrderByAggregation(aggName string, asc bool) *TermsAggregation {
@@ -220,6 +248,31 @@ func (a *TermsAggregation) IncludeTerms(terms ...string) *TermsAggregation {
 	return a
 }
 
+// IncludeValues restricts the buckets to the given exact term values.
+// In contrast to Include, which takes a regular expression, the values
+// are serialized as an array. The values are sorted and de-duplicated so
+// that equal allowlists result in identical requests, which helps
+// the request cache.
+//
+// The whole list is sent to and parsed by every shard. Allowlists with
+// many thousands of values increase request size and latency noticeably,
+// and the request must stay below http.max_content_length (100mb by
+// default). If the list gets that large, consider filtering the
+// documents with a terms query instead.
+func (a *TermsAggregation) IncludeValues(values ...string) *TermsAggregation {
+	terms := make([]string, 0, len(a.includeTerms)+len(values))
+	terms = append(terms, a.includeTerms...)
+	terms = append(terms, values...)
+	sort.Strings(terms)
+	a.includeTerms = make([]string, 0, len(terms))
+	for i, term := range terms {
+		if i == 0 || term != terms[i-1] {
+			a.includeTerms = append(a.includeTerms, term)
+		}
+	}
+	return a
+}
+
 func (a *TermsAggregation) ExcludeTerms(terms ...string) *TermsAggregation {
 	a.excludeTerms = append(a.excludeTerms, terms...)
 	return a
@@ -26,6 +26,57 @@ func TestTermsAggregation(t *testing.T) {
 	}
 }
 
+func TestTermsAggregationOrderByKeyAsc(t *testing.T) {
+	agg := NewTermsAggregation().Field("category").Size(20).OrderByKeyAsc()
+	src, err := agg.Source()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(src)
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"terms":{"field":"category","order":{"_key":"asc"},"size":20}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
+
+func TestTermsAggregationWithZeroMinDocCount(t *testing.T) {
+	agg := NewTermsAggregation().Field("tags").Size(50).MinDocCount(0)
+	src, err := agg.Source()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(src)
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"terms":{"field":"tags","min_doc_count":0,"size":50}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
+
+func TestTermsAggregationWithIncludeValues(t *testing.T) {
+	agg := NewTermsAggregation().Field("color").IncludeValues("red", "blue", "yellow", "green", "black", "blue")
+	src, err := agg.Source()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(src)
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"terms":{"field":"color","include":["black","blue","green","red","yellow"]}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
+
 func TestTermsAggregationWithSubAggregation(t *testing.T) {
 	subAgg := NewAvgAggregation().Field("height")
 	agg := NewTermsAggregation().Field("gender").Size(10).
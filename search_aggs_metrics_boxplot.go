@@ -0,0 +1,105 @@
+// Copyright 2012-present Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+// BoxplotAggregation is a metrics aggregation that computes boxplot
+// of numeric values extracted from the aggregated documents.
+// See: https://www.elastic.co/guide/en/elasticsearch/reference/current/search-aggregations-metrics-boxplot-aggregation.html
+type BoxplotAggregation struct {
+	field           string
+	script          *Script
+	subAggregations map[string]Aggregation
+	meta            map[string]interface{}
+	compression     *float64
+}
+
+func NewBoxplotAggregation() *BoxplotAggregation {
+	return &BoxplotAggregation{
+		subAggregations: make(map[string]Aggregation),
+	}
+}
+
+func (a *BoxplotAggregation) Field(field string) *BoxplotAggregation {
+	a.field = field
+	return a
+}
+
+func (a *BoxplotAggregation) Script(script *Script) *BoxplotAggregation {
+	a.script = script
+	return a
+}
+
+func (a *BoxplotAggregation) SubAggregation(name string, subAggregation Aggregation) *BoxplotAggregation {
+	a.subAggregations[name] = subAggregation
+	return a
+}
+
+// Meta sets the meta data to be included in the aggregation response.
+func (a *BoxplotAggregation) Meta(metaData map[string]interface{}) *BoxplotAggregation {
+	a.meta = metaData
+	return a
+}
+
+// Compression trades memory for accuracy of the underlying TDigest
+// algorithm. It defaults to 100.
+func (a *BoxplotAggregation) Compression(compression float64) *BoxplotAggregation {
+	a.compression = &compression
+	return a
+}
+
+func (a *BoxplotAggregation) Source() (interface{}, error) {
+	// Example:
+	//	{
+	//    "aggs" : {
+	//      "load_time_boxplot" : {
+	//           "boxplot" : {
+	//               "field" : "load_time"
+	//           }
+	//       }
+	//    }
+	//	}
+	// This method returns only the
+	//   { "boxplot" : { "field" : "load_time" } }
+	// part.
+
+	source := make(map[string]interface{})
+	opts := make(map[string]interface{})
+	source["boxplot"] = opts
+
+	// ValuesSourceAggregationBuilder
+	if a.field != "" {
+		opts["field"] = a.field
+	}
+	if a.script != nil {
+		src, err := a.script.Source()
+		if err != nil {
+			return nil, err
+		}
+		opts["script"] = src
+	}
+	if a.compression != nil {
+		opts["compression"] = *a.compression
+	}
+
+	// AggregationBuilder (SubAggregations)
+	if len(a.subAggregations) > 0 {
+		aggsMap := make(map[string]interface{})
+		source["aggregations"] = aggsMap
+		for name, aggregate := range a.subAggregations {
+			src, err := aggregate.Source()
+			if err != nil {
+				return nil, err
+			}
+			aggsMap[name] = src
+		}
+	}
+
+	// Add Meta data if available
+	if len(a.meta) > 0 {
+		source["meta"] = a.meta
+	}
+
+	return source, nil
+}
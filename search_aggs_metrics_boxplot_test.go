@@ -0,0 +1,63 @@
+// Copyright 2012-present Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestBoxplotAggregation(t *testing.T) {
+	agg := NewBoxplotAggregation().Field("load_time")
+	src, err := agg.Source()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(src)
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"boxplot":{"field":"load_time"}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
+
+func TestBoxplotAggregationWithOptions(t *testing.T) {
+	agg := NewBoxplotAggregation().
+		Script(NewScript("doc['load_time'].value / params.timeUnit").Param("timeUnit", 1000)).
+		Compression(200)
+	src, err := agg.Source()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(src)
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"boxplot":{"compression":200,"script":{"inline":"doc['load_time'].value / params.timeUnit","params":{"timeUnit":1000}}}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
+
+func TestBoxplotAggregationWithMetaData(t *testing.T) {
+	agg := NewBoxplotAggregation().Field("load_time").Meta(map[string]interface{}{"name": "Oliver"})
+	src, err := agg.Source()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(src)
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"boxplot":{"field":"load_time"},"meta":{"name":"Oliver"}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
@@ -0,0 +1,105 @@
+// Copyright 2012-present Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+// GeoLineAggregation is a metric aggregation that aggregates all
+// geo_point values within a bucket into a LineString ordered by the
+// chosen sort field.
+// See: https://www.elastic.co/guide/en/elasticsearch/reference/current/search-aggregations-metrics-geo-line.html
+type GeoLineAggregation struct {
+	point       string
+	sort        string
+	includeSort *bool
+	sortOrder   string
+	size        *int
+	meta        map[string]interface{}
+}
+
+func NewGeoLineAggregation() *GeoLineAggregation {
+	return &GeoLineAggregation{}
+}
+
+// Point is the name of the geo_point field.
+func (a *GeoLineAggregation) Point(field string) *GeoLineAggregation {
+	a.point = field
+	return a
+}
+
+// Sort is the name of the numeric field to use as the sort key
+// for ordering the points.
+func (a *GeoLineAggregation) Sort(field string) *GeoLineAggregation {
+	a.sort = field
+	return a
+}
+
+// IncludeSort, if true, includes an array of the sort values in the
+// feature properties of the result.
+func (a *GeoLineAggregation) IncludeSort(includeSort bool) *GeoLineAggregation {
+	a.includeSort = &includeSort
+	return a
+}
+
+// SortOrder is the order in which the line is sorted, i.e. "asc"
+// (the default) or "desc".
+func (a *GeoLineAggregation) SortOrder(sortOrder string) *GeoLineAggregation {
+	a.sortOrder = sortOrder
+	return a
+}
+
+// Size is the maximum length of the line represented in the aggregation.
+func (a *GeoLineAggregation) Size(size int) *GeoLineAggregation {
+	a.size = &size
+	return a
+}
+
+// Meta sets the meta data to be included in the aggregation response.
+func (a *GeoLineAggregation) Meta(metaData map[string]interface{}) *GeoLineAggregation {
+	a.meta = metaData
+	return a
+}
+
+func (a *GeoLineAggregation) Source() (interface{}, error) {
+	// Example:
+	//	{
+	//    "aggs" : {
+	//      "line" : {
+	//           "geo_line" : {
+	//               "point" : { "field" : "location" },
+	//               "sort" : { "field" : "@timestamp" }
+	//           }
+	//       }
+	//    }
+	//	}
+	// This method returns only the
+	//   { "geo_line" : { ... } }
+	// part.
+
+	source := make(map[string]interface{})
+	opts := make(map[string]interface{})
+	source["geo_line"] = opts
+
+	if a.point != "" {
+		opts["point"] = map[string]interface{}{"field": a.point}
+	}
+	if a.sort != "" {
+		opts["sort"] = map[string]interface{}{"field": a.sort}
+	}
+	if a.includeSort != nil {
+		opts["include_sort"] = *a.includeSort
+	}
+	if a.sortOrder != "" {
+		opts["sort_order"] = a.sortOrder
+	}
+	if a.size != nil {
+		opts["size"] = *a.size
+	}
+
+	// Add Meta data if available
+	if len(a.meta) > 0 {
+		source["meta"] = a.meta
+	}
+
+	return source, nil
+}
@@ -0,0 +1,49 @@
+// Copyright 2012-present Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestGeoLineAggregation(t *testing.T) {
+	agg := NewGeoLineAggregation().Point("location").Sort("@timestamp")
+	src, err := agg.Source()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(src)
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"geo_line":{"point":{"field":"location"},"sort":{"field":"@timestamp"}}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
+
+func TestGeoLineAggregationWithOptions(t *testing.T) {
+	agg := NewGeoLineAggregation().
+		Point("location").
+		Sort("@timestamp").
+		IncludeSort(true).
+		SortOrder("desc").
+		Size(100)
+	src, err := agg.Source()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(src)
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"geo_line":{"include_sort":true,"point":{"field":"location"},"size":100,"sort":{"field":"@timestamp"},"sort_order":"desc"}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
@@ -0,0 +1,61 @@
+// Copyright 2012-present Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestMedianAbsoluteDeviationAggregation(t *testing.T) {
+	agg := NewMedianAbsoluteDeviationAggregation().Field("rating")
+	src, err := agg.Source()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(src)
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"median_absolute_deviation":{"field":"rating"}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
+
+func TestMedianAbsoluteDeviationAggregationWithOptions(t *testing.T) {
+	agg := NewMedianAbsoluteDeviationAggregation().Field("rating").Compression(100).Format("0.00")
+	src, err := agg.Source()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(src)
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"median_absolute_deviation":{"compression":100,"field":"rating","format":"0.00"}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
+
+func TestMedianAbsoluteDeviationAggregationWithScript(t *testing.T) {
+	agg := NewMedianAbsoluteDeviationAggregation().Script(NewScript("doc['rating'].value * params.scaleFactor").Param("scaleFactor", 2))
+	src, err := agg.Source()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(src)
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"median_absolute_deviation":{"script":{"inline":"doc['rating'].value * params.scaleFactor","params":{"scaleFactor":2}}}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
@@ -0,0 +1,142 @@
+// Copyright 2012-present Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+// ScriptedMetricAggregation is a metric aggregation that executes using
+// scripts to provide a metric output.
+// See: https://www.elastic.co/guide/en/elasticsearch/reference/current/search-aggregations-metrics-scripted-metric-aggregation.html
+type ScriptedMetricAggregation struct {
+	initScript      *Script
+	mapScript       *Script
+	combineScript   *Script
+	reduceScript    *Script
+	params          map[string]interface{}
+	subAggregations map[string]Aggregation
+	meta            map[string]interface{}
+}
+
+func NewScriptedMetricAggregation() *ScriptedMetricAggregation {
+	return &ScriptedMetricAggregation{
+		subAggregations: make(map[string]Aggregation),
+	}
+}
+
+// InitScript is executed prior to any collection of documents.
+func (a *ScriptedMetricAggregation) InitScript(script *Script) *ScriptedMetricAggregation {
+	a.initScript = script
+	return a
+}
+
+// MapScript is executed once per document collected. It is required.
+func (a *ScriptedMetricAggregation) MapScript(script *Script) *ScriptedMetricAggregation {
+	a.mapScript = script
+	return a
+}
+
+// CombineScript is executed once on each shard after document
+// collection is complete.
+func (a *ScriptedMetricAggregation) CombineScript(script *Script) *ScriptedMetricAggregation {
+	a.combineScript = script
+	return a
+}
+
+// ReduceScript is executed once on the coordinating node after all
+// shards have returned their results.
+func (a *ScriptedMetricAggregation) ReduceScript(script *Script) *ScriptedMetricAggregation {
+	a.reduceScript = script
+	return a
+}
+
+// Params are passed to all of the scripts.
+func (a *ScriptedMetricAggregation) Params(params map[string]interface{}) *ScriptedMetricAggregation {
+	a.params = params
+	return a
+}
+
+func (a *ScriptedMetricAggregation) SubAggregation(name string, subAggregation Aggregation) *ScriptedMetricAggregation {
+	a.subAggregations[name] = subAggregation
+	return a
+}
+
+// Meta sets the meta data to be included in the aggregation response.
+func (a *ScriptedMetricAggregation) Meta(metaData map[string]interface{}) *ScriptedMetricAggregation {
+	a.meta = metaData
+	return a
+}
+
+func (a *ScriptedMetricAggregation) Source() (interface{}, error) {
+	// Example:
+	//	{
+	//    "aggs" : {
+	//      "profit" : {
+	//           "scripted_metric" : {
+	//               "init_script" : "params._agg.transactions = []",
+	//               "map_script" : "params._agg.transactions.add(doc.type.value == 'sale' ? doc.amount.value : -1 * doc.amount.value)",
+	//               "combine_script" : "double profit = 0; for (t in params._agg.transactions) { profit += t } return profit",
+	//               "reduce_script" : "double profit = 0; for (a in params._aggs) { profit += a } return profit"
+	//           }
+	//       }
+	//    }
+	//	}
+	// This method returns only the
+	//   { "scripted_metric" : { ... } }
+	// part.
+
+	source := make(map[string]interface{})
+	opts := make(map[string]interface{})
+	source["scripted_metric"] = opts
+
+	if a.initScript != nil {
+		src, err := a.initScript.Source()
+		if err != nil {
+			return nil, err
+		}
+		opts["init_script"] = src
+	}
+	if a.mapScript != nil {
+		src, err := a.mapScript.Source()
+		if err != nil {
+			return nil, err
+		}
+		opts["map_script"] = src
+	}
+	if a.combineScript != nil {
+		src, err := a.combineScript.Source()
+		if err != nil {
+			return nil, err
+		}
+		opts["combine_script"] = src
+	}
+	if a.reduceScript != nil {
+		src, err := a.reduceScript.Source()
+		if err != nil {
+			return nil, err
+		}
+		opts["reduce_script"] = src
+	}
+	if len(a.params) > 0 {
+		opts["params"] = a.params
+	}
+
+	// AggregationBuilder (SubAggregations)
+	if len(a.subAggregations) > 0 {
+		aggsMap := make(map[string]interface{})
+		source["aggregations"] = aggsMap
+		for name, aggregate := range a.subAggregations {
+			src, err := aggregate.Source()
+			if err != nil {
+				return nil, err
+			}
+			aggsMap[name] = src
+		}
+	}
+
+	// Add Meta data if available
+	if len(a.meta) > 0 {
+		source["meta"] = a.meta
+	}
+
+	return source, nil
+}
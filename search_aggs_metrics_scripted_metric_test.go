@@ -0,0 +1,50 @@
+// Copyright 2012-present Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestScriptedMetricAggregation(t *testing.T) {
+	agg := NewScriptedMetricAggregation().
+		InitScript(NewScript("params._agg.transactions = []")).
+		MapScript(NewScript("params._agg.transactions.add(doc.amount.value)")).
+		CombineScript(NewScript("double profit = 0; for (t in params._agg.transactions) { profit += t } return profit")).
+		ReduceScript(NewScript("double profit = 0; for (a in params._aggs) { profit += a } return profit"))
+	src, err := agg.Source()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(src)
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"scripted_metric":{"combine_script":"double profit = 0; for (t in params._agg.transactions) { profit += t } return profit","init_script":"params._agg.transactions = []","map_script":"params._agg.transactions.add(doc.amount.value)","reduce_script":"double profit = 0; for (a in params._aggs) { profit += a } return profit"}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
+
+func TestScriptedMetricAggregationWithParams(t *testing.T) {
+	agg := NewScriptedMetricAggregation().
+		MapScript(NewScriptId("map_profit")).
+		Params(map[string]interface{}{"field": "amount"})
+	src, err := agg.Source()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(src)
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"scripted_metric":{"map_script":{"id":"map_profit"},"params":{"field":"amount"}}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
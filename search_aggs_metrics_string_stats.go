@@ -0,0 +1,116 @@
+// Copyright 2012-present Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+// StringStatsAggregation is a multi-value metrics aggregation that
+// computes statistics over string values extracted from the aggregated
+// documents, e.g. the length of the strings and their Shannon entropy.
+// See: https://www.elastic.co/guide/en/elasticsearch/reference/current/search-aggregations-metrics-string-stats-aggregation.html
+type StringStatsAggregation struct {
+	field            string
+	script           *Script
+	missing          interface{}
+	showDistribution *bool
+	subAggregations  map[string]Aggregation
+	meta             map[string]interface{}
+}
+
+func NewStringStatsAggregation() *StringStatsAggregation {
+	return &StringStatsAggregation{
+		subAggregations: make(map[string]Aggregation),
+	}
+}
+
+func (a *StringStatsAggregation) Field(field string) *StringStatsAggregation {
+	a.field = field
+	return a
+}
+
+func (a *StringStatsAggregation) Script(script *Script) *StringStatsAggregation {
+	a.script = script
+	return a
+}
+
+// Missing configures the value to use when documents miss a value.
+func (a *StringStatsAggregation) Missing(missing interface{}) *StringStatsAggregation {
+	a.missing = missing
+	return a
+}
+
+// ShowDistribution, if true, makes Elasticsearch return the probability
+// distribution of all characters in the response.
+func (a *StringStatsAggregation) ShowDistribution(showDistribution bool) *StringStatsAggregation {
+	a.showDistribution = &showDistribution
+	return a
+}
+
+func (a *StringStatsAggregation) SubAggregation(name string, subAggregation Aggregation) *StringStatsAggregation {
+	a.subAggregations[name] = subAggregation
+	return a
+}
+
+// Meta sets the meta data to be included in the aggregation response.
+func (a *StringStatsAggregation) Meta(metaData map[string]interface{}) *StringStatsAggregation {
+	a.meta = metaData
+	return a
+}
+
+func (a *StringStatsAggregation) Source() (interface{}, error) {
+	// Example:
+	//	{
+	//    "aggs" : {
+	//      "message_stats" : {
+	//           "string_stats" : {
+	//               "field" : "message.keyword"
+	//           }
+	//       }
+	//    }
+	//	}
+	// This method returns only the
+	//   { "string_stats" : { "field" : "message.keyword" } }
+	// part.
+
+	source := make(map[string]interface{})
+	opts := make(map[string]interface{})
+	source["string_stats"] = opts
+
+	// ValuesSourceAggregationBuilder
+	if a.field != "" {
+		opts["field"] = a.field
+	}
+	if a.script != nil {
+		src, err := a.script.Source()
+		if err != nil {
+			return nil, err
+		}
+		opts["script"] = src
+	}
+	if a.missing != nil {
+		opts["missing"] = a.missing
+	}
+	if a.showDistribution != nil {
+		opts["show_distribution"] = *a.showDistribution
+	}
+
+	// AggregationBuilder (SubAggregations)
+	if len(a.subAggregations) > 0 {
+		aggsMap := make(map[string]interface{})
+		source["aggregations"] = aggsMap
+		for name, aggregate := range a.subAggregations {
+			src, err := aggregate.Source()
+			if err != nil {
+				return nil, err
+			}
+			aggsMap[name] = src
+		}
+	}
+
+	// Add Meta data if available
+	if len(a.meta) > 0 {
+		source["meta"] = a.meta
+	}
+
+	return source, nil
+}
@@ -0,0 +1,171 @@
+// Copyright 2012-present Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+// TTestAggregation is a single-value metrics aggregation that performs
+// a statistical hypothesis test in which the test statistic follows a
+// Student's t-distribution under the null hypothesis. It compares the
+// two populations A and B.
+// See: https://www.elastic.co/guide/en/elasticsearch/reference/current/search-aggregations-metrics-ttest-aggregation.html
+type TTestAggregation struct {
+	a               *TTestPopulation
+	b               *TTestPopulation
+	testType        string
+	subAggregations map[string]Aggregation
+	meta            map[string]interface{}
+}
+
+func NewTTestAggregation() *TTestAggregation {
+	return &TTestAggregation{
+		subAggregations: make(map[string]Aggregation),
+	}
+}
+
+// A sets the first population.
+func (a *TTestAggregation) A(population *TTestPopulation) *TTestAggregation {
+	a.a = population
+	return a
+}
+
+// B sets the second population.
+func (a *TTestAggregation) B(population *TTestPopulation) *TTestAggregation {
+	a.b = population
+	return a
+}
+
+// Type sets the type of the test, i.e. "paired", "homoscedastic",
+// or "heteroscedastic" (the default).
+func (a *TTestAggregation) Type(testType string) *TTestAggregation {
+	a.testType = testType
+	return a
+}
+
+func (a *TTestAggregation) SubAggregation(name string, subAggregation Aggregation) *TTestAggregation {
+	a.subAggregations[name] = subAggregation
+	return a
+}
+
+// Meta sets the meta data to be included in the aggregation response.
+func (a *TTestAggregation) Meta(metaData map[string]interface{}) *TTestAggregation {
+	a.meta = metaData
+	return a
+}
+
+func (a *TTestAggregation) Source() (interface{}, error) {
+	// Example:
+	//	{
+	//    "aggs" : {
+	//      "startup_time_ttest" : {
+	//           "t_test" : {
+	//               "a" : { "field" : "startup_time_before" },
+	//               "b" : { "field" : "startup_time_after" },
+	//               "type" : "paired"
+	//           }
+	//       }
+	//    }
+	//	}
+	// This method returns only the
+	//   { "t_test" : { ... } }
+	// part.
+
+	source := make(map[string]interface{})
+	opts := make(map[string]interface{})
+	source["t_test"] = opts
+
+	if a.a != nil {
+		src, err := a.a.Source()
+		if err != nil {
+			return nil, err
+		}
+		opts["a"] = src
+	}
+	if a.b != nil {
+		src, err := a.b.Source()
+		if err != nil {
+			return nil, err
+		}
+		opts["b"] = src
+	}
+	if a.testType != "" {
+		opts["type"] = a.testType
+	}
+
+	// AggregationBuilder (SubAggregations)
+	if len(a.subAggregations) > 0 {
+		aggsMap := make(map[string]interface{})
+		source["aggregations"] = aggsMap
+		for name, aggregate := range a.subAggregations {
+			src, err := aggregate.Source()
+			if err != nil {
+				return nil, err
+			}
+			aggsMap[name] = src
+		}
+	}
+
+	// Add Meta data if available
+	if len(a.meta) > 0 {
+		source["meta"] = a.meta
+	}
+
+	return source, nil
+}
+
+// -- TTestPopulation --
+
+// TTestPopulation specifies one of the populations compared by a
+// TTestAggregation. It is a field (or script) and an optional filter
+// that restricts the documents of the population.
+type TTestPopulation struct {
+	field  string
+	script *Script
+	filter Query
+}
+
+// NewTTestPopulation creates a new TTestPopulation.
+func NewTTestPopulation() *TTestPopulation {
+	return &TTestPopulation{}
+}
+
+// Field sets the field of the population.
+func (p *TTestPopulation) Field(field string) *TTestPopulation {
+	p.field = field
+	return p
+}
+
+// Script sets a script to compute the values of the population.
+func (p *TTestPopulation) Script(script *Script) *TTestPopulation {
+	p.script = script
+	return p
+}
+
+// Filter restricts the population to documents matching the query.
+func (p *TTestPopulation) Filter(filter Query) *TTestPopulation {
+	p.filter = filter
+	return p
+}
+
+// Source returns the JSON serializable body of the population.
+func (p *TTestPopulation) Source() (interface{}, error) {
+	source := make(map[string]interface{})
+	if p.field != "" {
+		source["field"] = p.field
+	}
+	if p.script != nil {
+		src, err := p.script.Source()
+		if err != nil {
+			return nil, err
+		}
+		source["script"] = src
+	}
+	if p.filter != nil {
+		src, err := p.filter.Source()
+		if err != nil {
+			return nil, err
+		}
+		source["filter"] = src
+	}
+	return source, nil
+}
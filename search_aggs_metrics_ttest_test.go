@@ -0,0 +1,50 @@
+// Copyright 2012-present Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestTTestAggregation(t *testing.T) {
+	agg := NewTTestAggregation().
+		A(NewTTestPopulation().Field("startup_time_before")).
+		B(NewTTestPopulation().Field("startup_time_after")).
+		Type("paired")
+	src, err := agg.Source()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(src)
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"t_test":{"a":{"field":"startup_time_before"},"b":{"field":"startup_time_after"},"type":"paired"}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
+
+func TestTTestAggregationWithFilters(t *testing.T) {
+	agg := NewTTestAggregation().
+		A(NewTTestPopulation().Field("startup_time_before").Filter(NewTermQuery("group", "A"))).
+		B(NewTTestPopulation().Field("startup_time_before").Filter(NewTermQuery("group", "B"))).
+		Type("heteroscedastic")
+	src, err := agg.Source()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(src)
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"t_test":{"a":{"field":"startup_time_before","filter":{"term":{"group":"A"}}},"b":{"field":"startup_time_before","filter":{"term":{"group":"B"}}},"type":"heteroscedastic"}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
@@ -28,3 +28,27 @@ func TestBucketScriptAggregation(t *testing.T) {
 		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
 	}
 }
+
+func TestBucketScriptAggregationWithBucketsPathsMap(t *testing.T) {
+	agg := NewBucketScriptAggregation().
+		BucketsPathsMap(map[string]string{
+			"sales":  "sales_sum",
+			"visits": "_count",
+		}).
+		Script(NewScript("params.sales / params.visits")).
+		GapPolicy("skip").
+		Format("0.00")
+	src, err := agg.Source()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(src)
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"bucket_script":{"buckets_path":{"sales":"sales_sum","visits":"_count"},"format":"0.00","gap_policy":"skip","script":"params.sales / params.visits"}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
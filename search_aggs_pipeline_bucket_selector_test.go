@@ -27,3 +27,23 @@ func TestBucketSelectorAggregation(t *testing.T) {
 		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
 	}
 }
+
+func TestBucketSelectorAggregationWithThreshold(t *testing.T) {
+	agg := NewBucketSelectorAggregation().
+		BucketsPathsMap(map[string]string{"ratio": "sales_per_visit"}).
+		Script(NewScript("params.ratio > params.threshold").Param("threshold", 1.5)).
+		GapSkip()
+	src, err := agg.Source()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(src)
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"bucket_selector":{"buckets_path":{"ratio":"sales_per_visit"},"gap_policy":"skip","script":{"inline":"params.ratio \u003e params.threshold","params":{"threshold":1.5}}}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
@@ -0,0 +1,110 @@
+// Copyright 2012-present Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+// BucketSortAggregation is a parent pipeline aggregation which sorts the
+// buckets of its parent multi-bucket aggregation. Zero or more sort
+// fields may be specified together with the corresponding sort order.
+// Each bucket may be sorted based on its _key, _count or its
+// sub-aggregations. In addition, From and Size may be set in order to
+// truncate the result buckets.
+//
+// For more details, see
+// https://www.elastic.co/guide/en/elasticsearch/reference/current/search-aggregations-pipeline-bucket-sort-aggregation.html
+type BucketSortAggregation struct {
+	sorters   []Sorter
+	from      *int
+	size      *int
+	gapPolicy string
+
+	meta map[string]interface{}
+}
+
+// NewBucketSortAggregation creates and initializes a new BucketSortAggregation.
+func NewBucketSortAggregation() *BucketSortAggregation {
+	return &BucketSortAggregation{
+		sorters: make([]Sorter, 0),
+	}
+}
+
+// Sort adds one or more sort orders. The buckets are sorted by the
+// sort orders in the order they are added.
+func (a *BucketSortAggregation) Sort(sorters ...Sorter) *BucketSortAggregation {
+	a.sorters = append(a.sorters, sorters...)
+	return a
+}
+
+// From sets the position of the first bucket in the response.
+// Buckets in positions prior to From are truncated.
+func (a *BucketSortAggregation) From(from int) *BucketSortAggregation {
+	a.from = &from
+	return a
+}
+
+// Size sets the number of buckets to return. Defaults to all buckets
+// of the parent aggregation.
+func (a *BucketSortAggregation) Size(size int) *BucketSortAggregation {
+	a.size = &size
+	return a
+}
+
+// GapPolicy defines what should be done when a gap in the series is discovered.
+// Valid values include "insert_zeros" or "skip". Default is "skip".
+func (a *BucketSortAggregation) GapPolicy(gapPolicy string) *BucketSortAggregation {
+	a.gapPolicy = gapPolicy
+	return a
+}
+
+// GapInsertZeros inserts zeros for gaps in the series.
+func (a *BucketSortAggregation) GapInsertZeros() *BucketSortAggregation {
+	a.gapPolicy = "insert_zeros"
+	return a
+}
+
+// GapSkip skips gaps in the series.
+func (a *BucketSortAggregation) GapSkip() *BucketSortAggregation {
+	a.gapPolicy = "skip"
+	return a
+}
+
+// Meta sets the meta data to be included in the aggregation response.
+func (a *BucketSortAggregation) Meta(metaData map[string]interface{}) *BucketSortAggregation {
+	a.meta = metaData
+	return a
+}
+
+func (a *BucketSortAggregation) Source() (interface{}, error) {
+	source := make(map[string]interface{})
+	params := make(map[string]interface{})
+	source["bucket_sort"] = params
+
+	if len(a.sorters) > 0 {
+		var sortarr []interface{}
+		for _, sorter := range a.sorters {
+			src, err := sorter.Source()
+			if err != nil {
+				return nil, err
+			}
+			sortarr = append(sortarr, src)
+		}
+		params["sort"] = sortarr
+	}
+	if a.from != nil {
+		params["from"] = *a.from
+	}
+	if a.size != nil {
+		params["size"] = *a.size
+	}
+	if a.gapPolicy != "" {
+		params["gap_policy"] = a.gapPolicy
+	}
+
+	// Add Meta data if available
+	if len(a.meta) > 0 {
+		source["meta"] = a.meta
+	}
+
+	return source, nil
+}
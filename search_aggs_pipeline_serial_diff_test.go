@@ -25,3 +25,20 @@ func TestSerialDiffAggregation(t *testing.T) {
 		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
 	}
 }
+
+func TestSerialDiffAggregationWithOptions(t *testing.T) {
+	agg := NewSerialDiffAggregation().BucketsPath("the_sum").Lag(7).GapSkip().Format("0.0")
+	src, err := agg.Source()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(src)
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"serial_diff":{"buckets_path":"the_sum","format":"0.0","gap_policy":"skip","lag":7}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
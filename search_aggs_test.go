@@ -1037,6 +1037,172 @@ func TestAggsMarshal(t *testing.T) {
 	}
 }
 
+func TestAggsPath(t *testing.T) {
+	s := `{
+	"sales" : {
+		"doc_count" : 42,
+		"byDate" : {
+			"buckets" : [
+				{
+					"key_as_string" : "2017-01-01",
+					"key" : 1483228800000,
+					"doc_count" : 30,
+					"byCategory" : {
+						"doc_count_error_upper_bound" : 0,
+						"sum_other_doc_count" : 0,
+						"buckets" : [
+							{
+								"key" : "shoes",
+								"doc_count" : 7,
+								"avgPrice" : {
+									"value" : 12.5
+								}
+							}
+						]
+					}
+				},
+				{
+					"key_as_string" : "2017-01-02",
+					"key" : 1483315200000,
+					"doc_count" : 12,
+					"byCategory" : {
+						"doc_count_error_upper_bound" : 0,
+						"sum_other_doc_count" : 0,
+						"buckets" : []
+					}
+				}
+			]
+		},
+		"byChannel" : {
+			"buckets" : {
+				"online" : {
+					"doc_count" : 10,
+					"avgPrice" : {
+						"value" : 9.5
+					}
+				}
+			}
+		}
+	}
+}`
+
+	aggs := new(Aggregations)
+	err := json.Unmarshal([]byte(s), &aggs)
+	if err != nil {
+		t.Fatalf("expected no error decoding; got: %v", err)
+	}
+
+	tests := []struct {
+		Path  string
+		Value float64
+	}{
+		{"sales>byDate['2017-01-01']>byCategory['shoes']>avgPrice", 12.5},
+		{"sales>byDate[1483228800000]>byCategory[shoes]>avgPrice", 12.5},
+		{"sales>byChannel['online']>avgPrice", 9.5},
+	}
+	for _, test := range tests {
+		raw, found := aggs.Path(test.Path)
+		if !found {
+			t.Fatalf("expected aggregation %q to be found; got: %v", test.Path, found)
+		}
+		var avg AggregationValueMetric
+		if err := json.Unmarshal(raw, &avg); err != nil {
+			t.Fatalf("expected no error decoding; got: %v", err)
+		}
+		if avg.Value == nil {
+			t.Fatalf("expected aggregation value != nil; got: %v", avg.Value)
+		}
+		if *avg.Value != test.Value {
+			t.Fatalf("expected aggregation value = %v; got: %v", test.Value, *avg.Value)
+		}
+	}
+
+	raw, found := aggs.Path("sales>byDate")
+	if !found {
+		t.Fatalf("expected aggregation to be found; got: %v", found)
+	}
+	var byDate AggregationBucketHistogramItems
+	if err := json.Unmarshal(raw, &byDate); err != nil {
+		t.Fatalf("expected no error decoding; got: %v", err)
+	}
+	if want, have := 2, len(byDate.Buckets); want != have {
+		t.Fatalf("expected %d buckets; got: %d", want, have)
+	}
+
+	raw, found = aggs.Path("sales>byDate['2017-01-02']")
+	if !found {
+		t.Fatalf("expected bucket to be found; got: %v", found)
+	}
+	var bucket AggregationBucketHistogramItem
+	if err := json.Unmarshal(raw, &bucket); err != nil {
+		t.Fatalf("expected no error decoding; got: %v", err)
+	}
+	if bucket.DocCount != 12 {
+		t.Fatalf("expected doc count = %d; got: %d", 12, bucket.DocCount)
+	}
+
+	for _, path := range []string{
+		"",
+		"sales>noSuchAgg",
+		"noSuchAgg>byDate",
+		"sales>byDate>byCategory",               // multi-bucket without key
+		"sales>byDate['2017-01-03']>byCategory", // no such bucket
+		"sales>byDate['2017-01-02']>byCategory['shoes']", // no such bucket
+		"sales['x']>byDate", // single-bucket with key
+	} {
+		if _, found := aggs.Path(path); found {
+			t.Errorf("expected path %q not to be found", path)
+		}
+	}
+}
+
+func TestAggsBucketComposite(t *testing.T) {
+	s := `{
+	"the_composite" : {
+		"after_key" : {"product": "mad max", "date": 1494288000000},
+		"buckets" : [
+			{
+				"key" : {"product": "rocky", "date": 1494201600000},
+				"doc_count" : 1
+			},
+			{
+				"key" : {"product": "mad max", "date": 1494288000000},
+				"doc_count" : 2
+			}
+		]
+	}
+}`
+
+	aggs := new(Aggregations)
+	err := json.Unmarshal([]byte(s), &aggs)
+	if err != nil {
+		t.Fatalf("expected no error decoding; got: %v", err)
+	}
+
+	agg, found := aggs.Composite("the_composite")
+	if !found {
+		t.Fatalf("expected aggregation to be found; got: %v", found)
+	}
+	if agg == nil {
+		t.Fatalf("expected aggregation != nil; got: %v", agg)
+	}
+	if want, have := "mad max", agg.AfterKey["product"]; want != have {
+		t.Fatalf("expected after_key product = %v; got: %v", want, have)
+	}
+	if len(agg.Buckets) != 2 {
+		t.Fatalf("expected %d buckets; got: %d", 2, len(agg.Buckets))
+	}
+	if want, have := "rocky", agg.Buckets[0].Key["product"]; want != have {
+		t.Errorf("expected key product = %v; got: %v", want, have)
+	}
+	if want, have := int64(1), agg.Buckets[0].DocCount; want != have {
+		t.Errorf("expected doc count = %d; got: %d", want, have)
+	}
+	if want, have := int64(2), agg.Buckets[1].DocCount; want != have {
+		t.Errorf("expected doc count = %d; got: %d", want, have)
+	}
+}
+
 func TestAggsMetricsMin(t *testing.T) {
 	s := `{
 	"min_price": {
@@ -1426,6 +1592,204 @@ func TestAggsMetricsPercentileRanks(t *testing.T) {
 	}
 }
 
+func TestAggsMetricsBoxplot(t *testing.T) {
+	s := `{
+  "load_time_boxplot": {
+		"min": 0.0,
+		"max": 990.0,
+		"q1": 167.5,
+		"q2": 445.0,
+		"q3": 722.5,
+		"lower": 0.0,
+		"upper": 990.0
+  }
+}`
+
+	aggs := new(Aggregations)
+	err := json.Unmarshal([]byte(s), &aggs)
+	if err != nil {
+		t.Fatalf("expected no error decoding; got: %v", err)
+	}
+
+	agg, found := aggs.Boxplot("load_time_boxplot")
+	if !found {
+		t.Fatalf("expected aggregation to be found; got: %v", found)
+	}
+	if agg == nil {
+		t.Fatalf("expected aggregation != nil; got: %v", agg)
+	}
+	tests := []struct {
+		name string
+		have *float64
+		want float64
+	}{
+		{"min", agg.Min, 0.0},
+		{"max", agg.Max, 990.0},
+		{"q1", agg.Q1, 167.5},
+		{"q2", agg.Q2, 445.0},
+		{"q3", agg.Q3, 722.5},
+		{"lower", agg.Lower, 0.0},
+		{"upper", agg.Upper, 990.0},
+	}
+	for _, tt := range tests {
+		if tt.have == nil {
+			t.Errorf("expected aggregation %s != nil; got: %v", tt.name, tt.have)
+			continue
+		}
+		if *tt.have != tt.want {
+			t.Errorf("expected aggregation %s = %v; got: %v", tt.name, tt.want, *tt.have)
+		}
+	}
+}
+
+func TestAggsMetricsMedianAbsoluteDeviation(t *testing.T) {
+	s := `{
+	"review_variability": {
+		"value": 2.0
+	}
+}`
+
+	aggs := new(Aggregations)
+	err := json.Unmarshal([]byte(s), &aggs)
+	if err != nil {
+		t.Fatalf("expected no error decoding; got: %v", err)
+	}
+
+	agg, found := aggs.MedianAbsoluteDeviation("review_variability")
+	if !found {
+		t.Fatalf("expected aggregation to be found; got: %v", found)
+	}
+	if agg == nil {
+		t.Fatalf("expected aggregation != nil; got: %v", agg)
+	}
+	if agg.Value == nil {
+		t.Fatalf("expected aggregation value != nil; got: %v", agg.Value)
+	}
+	if *agg.Value != float64(2) {
+		t.Fatalf("expected aggregation value = %v; got: %v", float64(2), *agg.Value)
+	}
+}
+
+func TestAggsMetricsStringStats(t *testing.T) {
+	s := `{
+	"message_stats": {
+		"count": 5,
+		"min_length": 24,
+		"max_length": 30,
+		"avg_length": 28.8,
+		"entropy": 3.94617750050791,
+		"distribution": {
+			" ": 0.1527777777777778,
+			"e": 0.14583333333333334,
+			"s": 0.09722222222222222
+		}
+	}
+}`
+
+	aggs := new(Aggregations)
+	err := json.Unmarshal([]byte(s), &aggs)
+	if err != nil {
+		t.Fatalf("expected no error decoding; got: %v", err)
+	}
+
+	agg, found := aggs.StringStats("message_stats")
+	if !found {
+		t.Fatalf("expected aggregation to be found; got: %v", found)
+	}
+	if agg == nil {
+		t.Fatalf("expected aggregation != nil; got: %v", agg)
+	}
+	if agg.Count != 5 {
+		t.Fatalf("expected aggregation count = %v; got: %v", 5, agg.Count)
+	}
+	if agg.MinLength == nil || *agg.MinLength != 24 {
+		t.Fatalf("expected aggregation min_length = %v; got: %v", 24, agg.MinLength)
+	}
+	if agg.MaxLength == nil || *agg.MaxLength != 30 {
+		t.Fatalf("expected aggregation max_length = %v; got: %v", 30, agg.MaxLength)
+	}
+	if agg.AvgLength == nil || *agg.AvgLength != 28.8 {
+		t.Fatalf("expected aggregation avg_length = %v; got: %v", 28.8, agg.AvgLength)
+	}
+	if agg.Entropy == nil || *agg.Entropy != 3.94617750050791 {
+		t.Fatalf("expected aggregation entropy = %v; got: %v", 3.94617750050791, agg.Entropy)
+	}
+	if len(agg.Distribution) != 3 {
+		t.Fatalf("expected %d distribution entries; got: %d", 3, len(agg.Distribution))
+	}
+	if agg.Distribution["e"] != 0.14583333333333334 {
+		t.Errorf("expected distribution for \"e\" = %v; got: %v", 0.14583333333333334, agg.Distribution["e"])
+	}
+}
+
+func TestAggsMetricsTTest(t *testing.T) {
+	s := `{
+	"startup_time_ttest": {
+		"value": 0.1914368843365979
+	}
+}`
+
+	aggs := new(Aggregations)
+	err := json.Unmarshal([]byte(s), &aggs)
+	if err != nil {
+		t.Fatalf("expected no error decoding; got: %v", err)
+	}
+
+	agg, found := aggs.TTest("startup_time_ttest")
+	if !found {
+		t.Fatalf("expected aggregation to be found; got: %v", found)
+	}
+	if agg == nil {
+		t.Fatalf("expected aggregation != nil; got: %v", agg)
+	}
+	if agg.Value == nil || *agg.Value != 0.1914368843365979 {
+		t.Fatalf("expected aggregation value = %v; got: %v", 0.1914368843365979, agg.Value)
+	}
+}
+
+func TestAggsMetricsScriptedMetric(t *testing.T) {
+	s := `{
+	"profit": {
+		"value": {
+			"sales": 430,
+			"costs": 200
+		}
+	}
+}`
+
+	aggs := new(Aggregations)
+	err := json.Unmarshal([]byte(s), &aggs)
+	if err != nil {
+		t.Fatalf("expected no error decoding; got: %v", err)
+	}
+
+	agg, found := aggs.ScriptedMetric("profit")
+	if !found {
+		t.Fatalf("expected aggregation to be found; got: %v", found)
+	}
+	if agg == nil {
+		t.Fatalf("expected aggregation != nil; got: %v", agg)
+	}
+	m, ok := agg.Value.(map[string]interface{})
+	if !ok {
+		t.Fatalf("expected aggregation value of type map[string]interface{}; got: %T", agg.Value)
+	}
+	if m["sales"] != float64(430) {
+		t.Errorf("expected sales = %v; got: %v", float64(430), m["sales"])
+	}
+
+	var profit map[string]int
+	if err := agg.DecodeValue(&profit); err != nil {
+		t.Fatalf("expected no error decoding value; got: %v", err)
+	}
+	if want, have := 430, profit["sales"]; want != have {
+		t.Errorf("expected sales = %v; got: %v", want, have)
+	}
+	if want, have := 200, profit["costs"]; want != have {
+		t.Errorf("expected costs = %v; got: %v", want, have)
+	}
+}
+
 func TestAggsMetricsTopHits(t *testing.T) {
 	s := `{
   "top-tags": {
@@ -1807,6 +2171,63 @@ func TestAggsBucketFiltersWithNamedBuckets(t *testing.T) {
 	}
 }
 
+func TestAggsBucketFiltersWithOtherBucket(t *testing.T) {
+	s := `{
+  "messages" : {
+    "buckets" : {
+      "errors" : {
+        "doc_count" : 1
+      },
+      "warnings" : {
+        "doc_count" : 2
+      },
+      "other_messages" : {
+        "doc_count" : 1
+      }
+    }
+  },
+  "unnamed" : {
+    "buckets" : [
+      { "doc_count" : 1 },
+      { "doc_count" : 2 },
+      { "doc_count" : 3 }
+    ]
+  }
+}`
+
+	aggs := new(Aggregations)
+	err := json.Unmarshal([]byte(s), &aggs)
+	if err != nil {
+		t.Fatalf("expected no error decoding; got: %v", err)
+	}
+
+	agg, found := aggs.Filters("messages")
+	if !found {
+		t.Fatalf("expected aggregation to be found; got: %v", found)
+	}
+	if len(agg.NamedBuckets) != 3 {
+		t.Fatalf("expected %d buckets; got: %d", 3, len(agg.NamedBuckets))
+	}
+	other, found := agg.NamedBuckets["other_messages"]
+	if !found {
+		t.Fatalf("expected other bucket to be found; got: %v", found)
+	}
+	if other.DocCount != 1 {
+		t.Fatalf("expected DocCount = %d; got: %d", 1, other.DocCount)
+	}
+
+	agg, found = aggs.Filters("unnamed")
+	if !found {
+		t.Fatalf("expected aggregation to be found; got: %v", found)
+	}
+	if len(agg.Buckets) != 3 {
+		t.Fatalf("expected %d buckets; got: %d", 3, len(agg.Buckets))
+	}
+	if agg.Buckets[2].DocCount != 3 {
+		t.Fatalf("expected DocCount of other bucket = %d; got: %d", 3, agg.Buckets[2].DocCount)
+	}
+}
+
 func TestAggsBucketMissing(t *testing.T) {
 	s := `{
 	"products_without_a_price" : {
@@ -1899,6 +2320,62 @@ func TestAggsBucketReverseNested(t *testing.T) {
 	}
 }
 
+func TestAggsBucketReverseNestedInsideNested(t *testing.T) {
+	s := `{
+	"comments": {
+		"doc_count": 5,
+		"top_usernames": {
+			"buckets": [
+				{
+					"key": "dana",
+					"doc_count": 3,
+					"comment_to_issue": {
+						"doc_count": 2,
+						"top_tags_per_comment": {
+							"buckets": [
+								{ "key": "tag1", "doc_count": 2 }
+							]
+						}
+					}
+				}
+			]
+		}
+	}
+}`
+
+	aggs := new(Aggregations)
+	err := json.Unmarshal([]byte(s), &aggs)
+	if err != nil {
+		t.Fatalf("expected no error decoding; got: %v", err)
+	}
+
+	nested, found := aggs.Nested("comments")
+	if !found {
+		t.Fatalf("expected aggregation to be found; got: %v", found)
+	}
+	terms, found := nested.Terms("top_usernames")
+	if !found {
+		t.Fatalf("expected sub aggregation to be found; got: %v", found)
+	}
+	if len(terms.Buckets) != 1 {
+		t.Fatalf("expected %d buckets; got: %d", 1, len(terms.Buckets))
+	}
+	agg, found := terms.Buckets[0].ReverseNested("comment_to_issue")
+	if !found {
+		t.Fatalf("expected reverse nested aggregation to be found; got: %v", found)
+	}
+	if agg.DocCount != 2 {
+		t.Fatalf("expected aggregation DocCount = %d; got: %d", 2, agg.DocCount)
+	}
+	tags, found := agg.Terms("top_tags_per_comment")
+	if !found {
+		t.Fatalf("expected sub aggregation to be found; got: %v", found)
+	}
+	if len(tags.Buckets) != 1 || tags.Buckets[0].DocCount != 2 {
+		t.Fatalf("expected one tag bucket with DocCount = %d; got: %v", 2, tags.Buckets)
+	}
+}
+
 func TestAggsBucketChildren(t *testing.T) {
 	s := `{
 	"to-answers": {
@@ -1924,6 +2401,44 @@ func TestAggsBucketChildren(t *testing.T) {
 	}
 }
 
+func TestAggsBucketParent(t *testing.T) {
+	s := `{
+	"to-questions": {
+		"doc_count" : 2,
+		"top-tags": {
+			"buckets": [
+				{ "key": "file-transfer", "doc_count": 1 },
+				{ "key": "windows-server-2003", "doc_count": 1 }
+			]
+		}
+	}
+}`
+
+	aggs := new(Aggregations)
+	err := json.Unmarshal([]byte(s), &aggs)
+	if err != nil {
+		t.Fatalf("expected no error decoding; got: %v", err)
+	}
+
+	agg, found := aggs.Parent("to-questions")
+	if !found {
+		t.Fatalf("expected aggregation to be found; got: %v", found)
+	}
+	if agg == nil {
+		t.Fatalf("expected aggregation != nil; got: %v", agg)
+	}
+	if agg.DocCount != 2 {
+		t.Fatalf("expected aggregation DocCount = %d; got: %d", 2, agg.DocCount)
+	}
+	terms, found := agg.Terms("top-tags")
+	if !found {
+		t.Fatalf("expected sub aggregation to be found; got: %v", found)
+	}
+	if len(terms.Buckets) != 2 {
+		t.Fatalf("expected %d buckets; got: %d", 2, len(terms.Buckets))
+	}
+}
+
 func TestAggsBucketTerms(t *testing.T) {
 	s := `{
 	"users" : {
@@ -2296,8 +2811,8 @@ func TestAggsBucketDateRange(t *testing.T) {
 	if agg.Buckets[0].To == nil {
 		t.Errorf("expected To != %v; got: %v", nil, agg.Buckets[0].To)
 	}
-	if *agg.Buckets[0].To != float64(1.3437792E+12) {
-		t.Errorf("expected To = %v; got: %v", float64(1.3437792E+12), *agg.Buckets[0].To)
+	if *agg.Buckets[0].To != float64(1.3437792e+12) {
+		t.Errorf("expected To = %v; got: %v", float64(1.3437792e+12), *agg.Buckets[0].To)
 	}
 	if agg.Buckets[0].ToAsString != "08-2012" {
 		t.Errorf("expected ToAsString = %q; got: %q", "08-2012", agg.Buckets[0].ToAsString)
@@ -2308,8 +2823,8 @@ func TestAggsBucketDateRange(t *testing.T) {
 	if agg.Buckets[1].From == nil {
 		t.Errorf("expected From != %v; got: %v", nil, agg.Buckets[1].From)
 	}
-	if *agg.Buckets[1].From != float64(1.3437792E+12) {
-		t.Errorf("expected From = %v; got: %v", float64(1.3437792E+12), *agg.Buckets[1].From)
+	if *agg.Buckets[1].From != float64(1.3437792e+12) {
+		t.Errorf("expected From = %v; got: %v", float64(1.3437792e+12), *agg.Buckets[1].From)
 	}
 	if agg.Buckets[1].FromAsString != "08-2012" {
 		t.Errorf("expected FromAsString = %q; got: %q", "08-2012", agg.Buckets[1].FromAsString)
@@ -2322,6 +2837,114 @@ func TestAggsBucketDateRange(t *testing.T) {
 	}
 }
 
+func TestAggsBucketDateRangeWithKeys(t *testing.T) {
+	s := `{
+	"range": {
+		"buckets": [
+			{
+				"key": "2012",
+				"from": 1.325376E+12,
+				"from_as_string": "2012-01-01",
+				"to": 1.3569984E+12,
+				"to_as_string": "2013-01-01",
+				"doc_count": 12
+			}
+		]
+	}
+}`
+
+	aggs := new(Aggregations)
+	err := json.Unmarshal([]byte(s), &aggs)
+	if err != nil {
+		t.Fatalf("expected no error decoding; got: %v", err)
+	}
+
+	agg, found := aggs.DateRange("range")
+	if !found {
+		t.Fatalf("expected aggregation to be found; got: %v", found)
+	}
+	if len(agg.Buckets) != 1 {
+		t.Fatalf("expected %d bucket entries; got: %d", 1, len(agg.Buckets))
+	}
+	bucket := agg.Buckets[0]
+	if bucket.Key != "2012" {
+		t.Errorf("expected Key = %q; got: %q", "2012", bucket.Key)
+	}
+	if bucket.From == nil || *bucket.From != float64(1.325376e+12) {
+		t.Errorf("expected From = %v; got: %v", float64(1.325376e+12), bucket.From)
+	}
+	if bucket.FromAsString != "2012-01-01" {
+		t.Errorf("expected FromAsString = %q; got: %q", "2012-01-01", bucket.FromAsString)
+	}
+	if bucket.To == nil || *bucket.To != float64(1.3569984e+12) {
+		t.Errorf("expected To = %v; got: %v", float64(1.3569984e+12), bucket.To)
+	}
+	if bucket.ToAsString != "2013-01-01" {
+		t.Errorf("expected ToAsString = %q; got: %q", "2013-01-01", bucket.ToAsString)
+	}
+	if bucket.DocCount != 12 {
+		t.Errorf("expected DocCount = %d; got: %d", 12, bucket.DocCount)
+	}
+}
+
+func TestAggsBucketIpRange(t *testing.T) {
+	s := `{
+	"ip_ranges": {
+		"buckets" : [
+			{
+				"key": "10.0.0.0/25",
+				"from": "10.0.0.0",
+				"to": "10.0.0.128",
+				"doc_count": 128
+			},
+			{
+				"key": "*-10.0.0.5",
+				"to": "10.0.0.5",
+				"doc_count": 10
+			}
+		]
+	}
+}`
+
+	aggs := new(Aggregations)
+	err := json.Unmarshal([]byte(s), &aggs)
+	if err != nil {
+		t.Fatalf("expected no error decoding; got: %v", err)
+	}
+
+	agg, found := aggs.IpRange("ip_ranges")
+	if !found {
+		t.Fatalf("expected aggregation to be found; got: %v", found)
+	}
+	if len(agg.Buckets) != 2 {
+		t.Fatalf("expected %d bucket entries; got: %d", 2, len(agg.Buckets))
+	}
+	if agg.Buckets[0].Key != "10.0.0.0/25" {
+		t.Errorf("expected Key = %q; got: %q", "10.0.0.0/25", agg.Buckets[0].Key)
+	}
+	if agg.Buckets[0].From != nil {
+		t.Errorf("expected From = %v; got: %v", nil, *agg.Buckets[0].From)
+	}
+	if agg.Buckets[0].FromAsString != "10.0.0.0" {
+		t.Errorf("expected FromAsString = %q; got: %q", "10.0.0.0", agg.Buckets[0].FromAsString)
+	}
+	if agg.Buckets[0].To != nil {
+		t.Errorf("expected To = %v; got: %v", nil, *agg.Buckets[0].To)
+	}
+	if agg.Buckets[0].ToAsString != "10.0.0.128" {
+		t.Errorf("expected ToAsString = %q; got: %q", "10.0.0.128", agg.Buckets[0].ToAsString)
+	}
+	if agg.Buckets[0].DocCount != 128 {
+		t.Errorf("expected DocCount = %d; got: %d", 128, agg.Buckets[0].DocCount)
+	}
+	if agg.Buckets[1].FromAsString != "" {
+		t.Errorf("expected FromAsString = %q; got: %q", "", agg.Buckets[1].FromAsString)
+	}
+	if agg.Buckets[1].ToAsString != "10.0.0.5" {
+		t.Errorf("expected ToAsString = %q; got: %q", "10.0.0.5", agg.Buckets[1].ToAsString)
+	}
+}
+
 func TestAggsBucketIPv4Range(t *testing.T) {
 	s := `{
 	"ip_ranges": {
@@ -2522,6 +3145,61 @@ func TestAggsBucketDateHistogram(t *testing.T) {
 	}
 }
 
+func TestAggsBucketAutoDateHistogram(t *testing.T) {
+	s := `{
+	"sales_over_time": {
+	  "buckets": [
+	      {
+	          "key_as_string": "2015-01-01",
+	          "key": 1420070400000,
+	          "doc_count": 3,
+	          "total_sales": { "value": 550.0 }
+	      },
+	      {
+	          "key_as_string": "2015-02-01",
+	          "key": 1422748800000,
+	          "doc_count": 2,
+	          "total_sales": { "value": 60.0 }
+	      }
+	  ],
+	  "interval": "1M"
+	}
+}`
+
+	aggs := new(Aggregations)
+	err := json.Unmarshal([]byte(s), &aggs)
+	if err != nil {
+		t.Fatalf("expected no error decoding; got: %v", err)
+	}
+
+	agg, found := aggs.AutoDateHistogram("sales_over_time")
+	if !found {
+		t.Fatalf("expected aggregation to be found; got: %v", found)
+	}
+	if agg == nil {
+		t.Fatalf("expected aggregation != nil; got: %v", agg)
+	}
+	if want, have := "1M", agg.Interval; want != have {
+		t.Errorf("expected interval %q; got: %q", want, have)
+	}
+	if len(agg.Buckets) != 2 {
+		t.Fatalf("expected %d bucket entries; got: %d", 2, len(agg.Buckets))
+	}
+	if agg.Buckets[1].Key != 1422748800000 {
+		t.Errorf("expected key %v; got: %v", 1422748800000, agg.Buckets[1].Key)
+	}
+	if agg.Buckets[1].DocCount != 2 {
+		t.Errorf("expected doc count %d; got: %d", 2, agg.Buckets[1].DocCount)
+	}
+	sum, found := agg.Buckets[1].Sum("total_sales")
+	if !found {
+		t.Fatalf("expected sub-aggregation to be found; got: %v", found)
+	}
+	if sum.Value == nil || *sum.Value != 60.0 {
+		t.Errorf("expected sub-aggregation value %v; got: %v", 60.0, sum.Value)
+	}
+}
+
 func TestAggsMetricsGeoBounds(t *testing.T) {
 	s := `{
   "viewport": {
@@ -2565,6 +3243,58 @@ func TestAggsMetricsGeoBounds(t *testing.T) {
 	}
 }
 
+func TestAggsMetricsGeoLine(t *testing.T) {
+	s := `{
+  "line": {
+    "type": "Feature",
+    "geometry": {
+      "type": "LineString",
+      "coordinates": [
+        [4.889187, 52.373184],
+        [4.901618, 52.369219],
+        [4.912350, 52.374081]
+      ]
+    },
+    "properties": {
+      "complete": true,
+      "sort_values": [1678528800000, 1678615200000, 1678701600000]
+    }
+  }
+}`
+
+	aggs := new(Aggregations)
+	err := json.Unmarshal([]byte(s), &aggs)
+	if err != nil {
+		t.Fatalf("expected no error decoding; got: %v", err)
+	}
+
+	agg, found := aggs.GeoLine("line")
+	if !found {
+		t.Fatalf("expected aggregation to be found; got: %v", found)
+	}
+	if agg == nil {
+		t.Fatalf("expected aggregation != nil; got: %v", agg)
+	}
+	if want, have := "Feature", agg.Type; want != have {
+		t.Errorf("expected Type = %q; got: %q", want, have)
+	}
+	if want, have := "LineString", agg.Geometry.Type; want != have {
+		t.Errorf("expected Geometry.Type = %q; got: %q", want, have)
+	}
+	if want, have := 3, len(agg.Geometry.Coordinates); want != have {
+		t.Fatalf("expected %d coordinates; got: %d", want, have)
+	}
+	if want, have := []float64{4.901618, 52.369219}, agg.Geometry.Coordinates[1]; want[0] != have[0] || want[1] != have[1] {
+		t.Errorf("expected Coordinates[1] = %v; got: %v", want, have)
+	}
+	if !agg.Properties.Complete {
+		t.Errorf("expected Properties.Complete = %v; got: %v", true, agg.Properties.Complete)
+	}
+	if want, have := 3, len(agg.Properties.SortValues); want != have {
+		t.Errorf("expected %d sort values; got: %d", want, have)
+	}
+}
+
 func TestAggsBucketGeoHash(t *testing.T) {
 	s := `{
 	"myLarge-GrainGeoHashGrid": {
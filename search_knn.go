@@ -0,0 +1,167 @@
+// Copyright 2012-present Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+import (
+	"errors"
+	"fmt"
+)
+
+// KnnSearch is the top-level approximate k-nearest neighbor search
+// of a search request, available as of Elasticsearch 8.0.
+//
+// Both K and NumCandidates are optional as of Elasticsearch 8.x. If K is
+// omitted, Elasticsearch uses the size of the search request. If
+// NumCandidates is omitted, it defaults to the smaller of 1.5 * k
+// and 10000.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/8.x/knn-search.html
+// for details.
+type KnnSearch struct {
+	field         string
+	queryVector   []float32
+	k             *int
+	numCandidates *int
+	filters       []Query
+	similarity    *float32
+	boost         *float32
+	innerHit      *InnerHit
+}
+
+// NewKnnSearch creates a new KnnSearch on the given dense_vector field.
+func NewKnnSearch(field string) *KnnSearch {
+	return &KnnSearch{field: field}
+}
+
+// Field is the name of the dense_vector field to search against.
+func (k *KnnSearch) Field(field string) *KnnSearch {
+	k.field = field
+	return k
+}
+
+// QueryVector is the vector to find the nearest neighbors of.
+func (k *KnnSearch) QueryVector(queryVector ...float32) *KnnSearch {
+	k.queryVector = queryVector
+	return k
+}
+
+// K is the number of nearest neighbors to return as top hits.
+func (k *KnnSearch) K(kk int) *KnnSearch {
+	k.k = &kk
+	return k
+}
+
+// NumCandidates is the number of nearest neighbor candidates to
+// consider per shard. It must not be less than K and not exceed 10000.
+func (k *KnnSearch) NumCandidates(numCandidates int) *KnnSearch {
+	k.numCandidates = &numCandidates
+	return k
+}
+
+// Filter adds one or more queries to filter the documents that can match.
+func (k *KnnSearch) Filter(filters ...Query) *KnnSearch {
+	k.filters = append(k.filters, filters...)
+	return k
+}
+
+// Similarity is the minimum similarity for a vector to be considered a match.
+func (k *KnnSearch) Similarity(similarity float32) *KnnSearch {
+	k.similarity = &similarity
+	return k
+}
+
+// Boost sets the boost for this kNN search.
+func (k *KnnSearch) Boost(boost float32) *KnnSearch {
+	k.boost = &boost
+	return k
+}
+
+// InnerHit returns the matching nested vectors of each hit as inner hits.
+// It is only supported on dense_vector fields inside a nested field,
+// e.g. "paragraph.vector", and requires Elasticsearch 8.11 or later.
+// Whether the field is nested is checked by Elasticsearch, not by Validate.
+// The inner hits of a hit can be accessed via SearchHit.InnerHits
+// by the nested path, or by the name of the inner hit if specified.
+func (k *KnnSearch) InnerHit(innerHit *InnerHit) *KnnSearch {
+	k.innerHit = innerHit
+	return k
+}
+
+// Validate checks that the combination of settings is accepted
+// by Elasticsearch.
+func (k *KnnSearch) Validate() error {
+	if k.field == "" {
+		return errors.New("elastic: field is required in KnnSearch")
+	}
+	if len(k.queryVector) == 0 {
+		return errors.New("elastic: query vector is required in KnnSearch")
+	}
+	if k.k != nil && *k.k < 1 {
+		return fmt.Errorf("elastic: k must be greater than 0 in KnnSearch, got %d", *k.k)
+	}
+	if k.numCandidates != nil {
+		if *k.numCandidates < 1 {
+			return fmt.Errorf("elastic: num_candidates must be greater than 0 in KnnSearch, got %d", *k.numCandidates)
+		}
+		if *k.numCandidates > 10000 {
+			return fmt.Errorf("elastic: num_candidates cannot exceed 10000 in KnnSearch, got %d", *k.numCandidates)
+		}
+		if k.k != nil && *k.numCandidates < *k.k {
+			return fmt.Errorf("elastic: num_candidates (%d) cannot be less than k (%d) in KnnSearch", *k.numCandidates, *k.k)
+		}
+	}
+	return nil
+}
+
+// Source returns the JSON-serializable data.
+func (k *KnnSearch) Source() (interface{}, error) {
+	// {
+	//   "field": "image-vector",
+	//   "query_vector": [-5, 9, -12],
+	//   "k": 10,
+	//   "num_candidates": 100
+	// }
+	if err := k.Validate(); err != nil {
+		return nil, err
+	}
+	source := make(map[string]interface{})
+	source["field"] = k.field
+	source["query_vector"] = k.queryVector
+	if k.k != nil {
+		source["k"] = *k.k
+	}
+	if k.numCandidates != nil {
+		source["num_candidates"] = *k.numCandidates
+	}
+	if len(k.filters) > 0 {
+		var filters []interface{}
+		for _, filter := range k.filters {
+			src, err := filter.Source()
+			if err != nil {
+				return nil, err
+			}
+			filters = append(filters, src)
+		}
+		if len(filters) == 1 {
+			source["filter"] = filters[0]
+		} else {
+			source["filter"] = filters
+		}
+	}
+	if k.similarity != nil {
+		source["similarity"] = *k.similarity
+	}
+	if k.boost != nil {
+		source["boost"] = *k.boost
+	}
+	if k.innerHit != nil {
+		src, err := k.innerHit.Source()
+		if err != nil {
+			return nil, err
+		}
+		source["inner_hits"] = src
+	}
+	return source, nil
+}
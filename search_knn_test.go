@@ -0,0 +1,183 @@
+// Copyright 2012-present Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestKnnSearchSource(t *testing.T) {
+	tests := []struct {
+		Knn      *KnnSearch
+		Expected string
+	}{
+		// k and num_candidates
+		{
+			NewKnnSearch("image-vector").QueryVector(-5, 9, -12).K(10).NumCandidates(100),
+			`{"field":"image-vector","k":10,"num_candidates":100,"query_vector":[-5,9,-12]}`,
+		},
+		// k omitted, num_candidates only
+		{
+			NewKnnSearch("image-vector").QueryVector(-5, 9, -12).NumCandidates(50),
+			`{"field":"image-vector","num_candidates":50,"query_vector":[-5,9,-12]}`,
+		},
+		// both omitted
+		{
+			NewKnnSearch("image-vector").QueryVector(1, 2),
+			`{"field":"image-vector","query_vector":[1,2]}`,
+		},
+		// k equals num_candidates
+		{
+			NewKnnSearch("image-vector").QueryVector(1).K(5).NumCandidates(5).Filter(NewTermQuery("file-type", "png")),
+			`{"field":"image-vector","filter":{"term":{"file-type":"png"}},"k":5,"num_candidates":5,"query_vector":[1]}`,
+		},
+	}
+
+	for i, test := range tests {
+		src, err := test.Knn.Source()
+		if err != nil {
+			t.Fatalf("case #%d: expected no error, got %v", i+1, err)
+		}
+		data, err := json.Marshal(src)
+		if err != nil {
+			t.Fatalf("case #%d: marshaling to JSON failed: %v", i+1, err)
+		}
+		if got := string(data); got != test.Expected {
+			t.Errorf("case #%d: expected\n%s\n,got:\n%s", i+1, test.Expected, got)
+		}
+	}
+}
+
+func TestKnnSearchInvalid(t *testing.T) {
+	tests := []*KnnSearch{
+		NewKnnSearch("").QueryVector(1, 2),
+		NewKnnSearch("image-vector"),
+		NewKnnSearch("image-vector").QueryVector(1).K(0),
+		NewKnnSearch("image-vector").QueryVector(1).NumCandidates(0),
+		NewKnnSearch("image-vector").QueryVector(1).NumCandidates(10001),
+		NewKnnSearch("image-vector").QueryVector(1).K(100).NumCandidates(10),
+	}
+
+	for i, knn := range tests {
+		if err := knn.Validate(); err == nil {
+			t.Errorf("case #%d: expected Validate to fail", i+1)
+		}
+		if _, err := knn.Source(); err == nil {
+			t.Errorf("case #%d: expected Source to fail", i+1)
+		}
+	}
+}
+
+func TestKnnSearchInnerHitLeavesNestedCheckToServer(t *testing.T) {
+	tests := []*KnnSearch{
+		NewKnnSearch("meta.vector").QueryVector(1).InnerHit(NewInnerHit()),
+		NewKnnSearch("vector").QueryVector(1).InnerHit(NewInnerHit()),
+	}
+
+	for i, knn := range tests {
+		if err := knn.Validate(); err != nil {
+			t.Errorf("case #%d: expected no error, got %v", i+1, err)
+		}
+	}
+}
+
+func TestSearchSourceKnn(t *testing.T) {
+	builder := NewSearchSource().Knn(NewKnnSearch("image-vector").QueryVector(1, 2).NumCandidates(20))
+	src, err := builder.Source()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(src)
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"knn":{"field":"image-vector","num_candidates":20,"query_vector":[1,2]}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
+
+func TestKnnSearchNestedWithInnerHits(t *testing.T) {
+	knn := NewKnnSearch("paragraph.vector").
+		QueryVector(0.45, 45).
+		K(2).
+		NumCandidates(2).
+		InnerHit(NewInnerHit().Size(1).FetchSource(false).DocvalueField("paragraph.text"))
+	src, err := knn.Source()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := string(data)
+	expected := `{"field":"paragraph.vector","inner_hits":{"_source":false,"docvalue_fields":["paragraph.text"],"size":1},"k":2,"num_candidates":2,"query_vector":[0.45,45]}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
+
+func TestKnnSearchNestedInnerHitsResponse(t *testing.T) {
+	body := `{
+		"took": 4,
+		"hits": {
+			"total": {"value": 1, "relation": "eq"},
+			"max_score": 1.0,
+			"hits": [{
+				"_index": "passage_vectors",
+				"_id": "2",
+				"_score": 0.9997144,
+				"inner_hits": {
+					"paragraph": {
+						"hits": {
+							"total": {"value": 2, "relation": "eq"},
+							"max_score": 0.9997144,
+							"hits": [{
+								"_index": "passage_vectors",
+								"_id": "2",
+								"_nested": {"field": "paragraph", "offset": 1},
+								"_score": 0.9997144,
+								"fields": {"paragraph": [{"vector": [0.45, 45]}]}
+							}]
+						}
+					}
+				}
+			}]
+		}
+	}`
+	var res SearchResult
+	if err := json.Unmarshal([]byte(body), &res); err != nil {
+		t.Fatal(err)
+	}
+	if want, have := 1, len(res.Hits.Hits); want != have {
+		t.Fatalf("expected %d hits; got: %d", want, have)
+	}
+	inner, found := res.Hits.Hits[0].InnerHits["paragraph"]
+	if !found || inner.Hits == nil {
+		t.Fatal("expected inner hits for paragraph")
+	}
+	if want, have := int64(2), inner.Hits.TotalHits; want != have {
+		t.Errorf("expected %d inner hits in total; got: %d", want, have)
+	}
+	if want, have := 1, len(inner.Hits.Hits); want != have {
+		t.Fatalf("expected %d inner hits; got: %d", want, have)
+	}
+	hit := inner.Hits.Hits[0]
+	if hit.Nested == nil {
+		t.Fatal("expected nested identity on inner hit")
+	}
+	if want, have := "paragraph", hit.Nested.Field; want != have {
+		t.Errorf("expected nested field %q; got: %q", want, have)
+	}
+	if want, have := 1, hit.Nested.Offset; want != have {
+		t.Errorf("expected nested offset %d; got: %d", want, have)
+	}
+	if _, found := hit.Fields["paragraph"]; !found {
+		t.Error("expected nested vector in fields")
+	}
+}
@@ -0,0 +1,42 @@
+// Copyright 2012-present Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"time"
+)
+
+// PreferenceSession is a random token to be used as a custom search
+// preference. Using the same preference for subsequent searches routes
+// them to the same shard copies, e.g. to get consistent ordering of
+// results while a user pages through them.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/5.1/search-request-preference.html
+// for details.
+type PreferenceSession string
+
+// NewPreferenceSession creates a new random PreferenceSession.
+func NewPreferenceSession() PreferenceSession {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		// Fall back to the current time; uniqueness is good enough here
+		return PreferenceSession(fmt.Sprintf("%x", time.Now().UnixNano()))
+	}
+	return PreferenceSession(hex.EncodeToString(b))
+}
+
+// String returns the token of the session.
+func (p PreferenceSession) String() string {
+	return string(p)
+}
+
+// Apply sets the session token as the preference of the given
+// SearchService and returns it.
+func (p PreferenceSession) Apply(s *SearchService) *SearchService {
+	return s.Preference(p.String())
+}
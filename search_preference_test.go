@@ -0,0 +1,34 @@
+// Copyright 2012-present Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+import "testing"
+
+func TestPreferenceSession(t *testing.T) {
+	client := setupTestClient(t)
+
+	session := NewPreferenceSession()
+	if session.String() == "" {
+		t.Fatal("expected a non-empty session token")
+	}
+	if other := NewPreferenceSession(); other == session {
+		t.Errorf("expected different tokens; got: %q and %q", session, other)
+	}
+
+	services := []*SearchService{
+		session.Apply(client.Search().Index(testIndexName).From(0).Size(10)),
+		session.Apply(client.Search().Index(testIndexName).From(10).Size(10)),
+		session.Apply(client.Search().Index(testIndexName).From(20).Size(10)),
+	}
+	for i, svc := range services {
+		_, params, err := svc.buildURL()
+		if err != nil {
+			t.Fatalf("case #%d: %v", i+1, err)
+		}
+		if want, have := session.String(), params.Get("preference"); want != have {
+			t.Errorf("case #%d: expected preference %q; got: %q", i+1, want, have)
+		}
+	}
+}
@@ -0,0 +1,143 @@
+// Copyright 2012-present Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestSearchSourceProfile(t *testing.T) {
+	builder := NewSearchSource().Query(NewMatchAllQuery()).Profile(true)
+	src, err := builder.Source()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(src)
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"profile":true,"query":{"match_all":{}}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
+
+func TestSearchResultProfileWithAggregations(t *testing.T) {
+	body := `{
+		"took": 3,
+		"timed_out": false,
+		"hits": { "total": 1, "max_score": 1.0, "hits": [] },
+		"profile": {
+			"shards": [{
+				"id": "[nodeId][twitter][0]",
+				"searches": [{
+					"query": [{
+						"type": "MatchAllDocsQuery",
+						"description": "*:*",
+						"time": "0.1ms",
+						"time_in_nanos": 100000,
+						"breakdown": { "score": 0, "build_scorer": 5000, "create_weight": 1000 }
+					}],
+					"rewrite_time": 1500,
+					"collector": [{
+						"name": "MultiCollector",
+						"reason": "search_multi",
+						"time_in_nanos": 20000,
+						"children": [
+							{ "name": "TotalHitCountCollector", "reason": "search_count", "time_in_nanos": 5000 }
+						]
+					}]
+				}],
+				"aggregations": [{
+					"type": "GlobalOrdinalsStringTermsAggregator",
+					"description": "users",
+					"time_in_nanos": 80000,
+					"breakdown": {
+						"reduce": 0,
+						"build_aggregation": 30000,
+						"initialise": 2000,
+						"collect": 48000
+					},
+					"children": [{
+						"type": "AvgAggregator",
+						"description": "avg_retweets",
+						"time_in_nanos": 10000,
+						"breakdown": {
+							"reduce": 0,
+							"build_aggregation": 1000,
+							"initialise": 500,
+							"collect": 8500
+						}
+					}]
+				}]
+			}]
+		}
+	}`
+	var res SearchResult
+	if err := json.Unmarshal([]byte(body), &res); err != nil {
+		t.Fatal(err)
+	}
+	if res.Profile == nil {
+		t.Fatal("expected profile; got: nil")
+	}
+	if want, have := 1, len(res.Profile.Shards); want != have {
+		t.Fatalf("expected %d shards; got: %d", want, have)
+	}
+	shard := res.Profile.Shards[0]
+	if want, have := "[nodeId][twitter][0]", shard.ID; want != have {
+		t.Errorf("expected shard id = %q; got: %q", want, have)
+	}
+
+	// Query profile
+	if want, have := 1, len(shard.Searches); want != have {
+		t.Fatalf("expected %d searches; got: %d", want, have)
+	}
+	search := shard.Searches[0]
+	if want, have := int64(1500), search.RewriteTime; want != have {
+		t.Errorf("expected rewrite_time = %d; got: %d", want, have)
+	}
+	if want, have := 1, len(search.Query); want != have {
+		t.Fatalf("expected %d query profiles; got: %d", want, have)
+	}
+	if want, have := int64(5000), search.Query[0].Breakdown["build_scorer"]; want != have {
+		t.Errorf("expected build_scorer = %d; got: %d", want, have)
+	}
+	if want, have := 1, len(search.Collector); want != have {
+		t.Fatalf("expected %d collectors; got: %d", want, have)
+	}
+	if want, have := 1, len(search.Collector[0].Children); want != have {
+		t.Fatalf("expected %d collector children; got: %d", want, have)
+	}
+
+	// Aggregation profile
+	if want, have := 1, len(shard.Aggregations); want != have {
+		t.Fatalf("expected %d aggregation profiles; got: %d", want, have)
+	}
+	agg := shard.Aggregations[0]
+	if want, have := "GlobalOrdinalsStringTermsAggregator", agg.Type; want != have {
+		t.Errorf("expected type = %q; got: %q", want, have)
+	}
+	if want, have := "users", agg.Description; want != have {
+		t.Errorf("expected description = %q; got: %q", want, have)
+	}
+	if want, have := int64(80000), agg.NodeTimeNanos; want != have {
+		t.Errorf("expected time_in_nanos = %d; got: %d", want, have)
+	}
+	if want, have := int64(48000), agg.Breakdown["collect"]; want != have {
+		t.Errorf("expected collect = %d; got: %d", want, have)
+	}
+	if want, have := 1, len(agg.Children); want != have {
+		t.Fatalf("expected %d children; got: %d", want, have)
+	}
+	child := agg.Children[0]
+	if want, have := "avg_retweets", child.Description; want != have {
+		t.Errorf("expected description = %q; got: %q", want, have)
+	}
+	if want, have := int64(8500), child.Breakdown["collect"]; want != have {
+		t.Errorf("expected collect = %d; got: %d", want, have)
+	}
+}
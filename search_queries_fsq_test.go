@@ -164,3 +164,58 @@ func TestFunctionScoreQueryWithGaussScoreFuncAndMultiValueMode(t *testing.T) {
 		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
 	}
 }
+
+func TestFieldValueFactorWithMissing(t *testing.T) {
+	q := NewFunctionScoreQuery().
+		Query(NewMatchAllQuery()).
+		AddScoreFunc(NewFieldValueFactorFunction().Field("likes").Factor(1.2).Modifier("log1p").Missing(1))
+	src, err := q.Source()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(src)
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"function_score":{"field_value_factor":{"factor":1.2,"field":"likes","missing":1,"modifier":"log1p"},"query":{"match_all":{}}}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
+
+func TestFunctionScoreQueryWithDecayFuncsOnDateField(t *testing.T) {
+	tests := []struct {
+		Func     ScoreFunction
+		Expected string
+	}{
+		{
+			NewGaussDecayFunction().FieldName("date").Origin("2013-09-17").Scale("10d").Offset("5d").Decay(0.5),
+			`{"function_score":{"gauss":{"date":{"decay":0.5,"offset":"5d","origin":"2013-09-17","scale":"10d"}},"query":{"match_all":{}}}}`,
+		},
+		{
+			NewExponentialDecayFunction().FieldName("date").Origin("now").Scale("7d"),
+			`{"function_score":{"exp":{"date":{"origin":"now","scale":"7d"}},"query":{"match_all":{}}}}`,
+		},
+		{
+			NewLinearDecayFunction().FieldName("date").Origin("now").Scale("30d").Decay(0.1),
+			`{"function_score":{"linear":{"date":{"decay":0.1,"origin":"now","scale":"30d"}},"query":{"match_all":{}}}}`,
+		},
+	}
+
+	for i, test := range tests {
+		q := NewFunctionScoreQuery().Query(NewMatchAllQuery()).AddScoreFunc(test.Func)
+		src, err := q.Source()
+		if err != nil {
+			t.Fatalf("case #%d: %v", i+1, err)
+		}
+		data, err := json.Marshal(src)
+		if err != nil {
+			t.Fatalf("case #%d: marshaling to JSON failed: %v", i+1, err)
+		}
+		got := string(data)
+		if got != test.Expected {
+			t.Errorf("case #%d: expected\n%s\n,got:\n%s", i+1, test.Expected, got)
+		}
+	}
+}
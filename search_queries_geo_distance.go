@@ -10,14 +10,15 @@ package elastic
 // For more details, see:
 // https://www.elastic.co/guide/en/elasticsearch/reference/current/query-dsl-geo-distance-query.html
 type GeoDistanceQuery struct {
-	name         string
-	distance     string
-	lat          float64
-	lon          float64
-	geohash      string
-	distanceType string
-	optimizeBbox string
-	queryName    string
+	name             string
+	distance         string
+	lat              float64
+	lon              float64
+	geohash          string
+	distanceType     string
+	optimizeBbox     string
+	validationMethod string
+	queryName        string
 }
 
 // NewGeoDistanceQuery creates and initializes a new GeoDistanceQuery.
@@ -57,6 +58,9 @@ func (q *GeoDistanceQuery) Distance(distance string) *GeoDistanceQuery {
 	return q
 }
 
+// DistanceType specifies how to compute the distance. It can be either
+// "arc" (default) or "plane" (faster, but inaccurate on long distances
+// and close to the poles).
 func (q *GeoDistanceQuery) DistanceType(distanceType string) *GeoDistanceQuery {
 	q.distanceType = distanceType
 	return q
@@ -67,6 +71,13 @@ func (q *GeoDistanceQuery) OptimizeBbox(optimizeBbox string) *GeoDistanceQuery {
 	return q
 }
 
+// ValidationMethod specifies the behavior for invalid or out of range
+// geo points. It can be "STRICT" (default), "COERCE", or "IGNORE_MALFORMED".
+func (q *GeoDistanceQuery) ValidationMethod(validationMethod string) *GeoDistanceQuery {
+	q.validationMethod = validationMethod
+	return q
+}
+
 func (q *GeoDistanceQuery) QueryName(queryName string) *GeoDistanceQuery {
 	q.queryName = queryName
 	return q
@@ -106,6 +117,9 @@ func (q *GeoDistanceQuery) Source() (interface{}, error) {
 	if q.optimizeBbox != "" {
 		params["optimize_bbox"] = q.optimizeBbox
 	}
+	if q.validationMethod != "" {
+		params["validation_method"] = q.validationMethod
+	}
 	if q.queryName != "" {
 		params["_name"] = q.queryName
 	}
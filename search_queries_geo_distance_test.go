@@ -68,3 +68,85 @@ func TestGeoDistanceQueryWithGeoHash(t *testing.T) {
 		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
 	}
 }
+
+func TestGeoDistanceQueryWithGeoPointForms(t *testing.T) {
+	fromString, err := GeoPointFromString("40,-70")
+	if err != nil {
+		t.Fatal(err)
+	}
+	fromGeohash, err := GeoPointFromGeohash("drm3btev3e86")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		Point    *GeoPoint
+		Expected string
+	}{
+		{
+			GeoPointFromLatLon(40, -70),
+			`{"geo_distance":{"distance":"200km","pin.location":{"lat":40,"lon":-70}}}`,
+		},
+		{
+			fromString,
+			`{"geo_distance":{"distance":"200km","pin.location":{"lat":40,"lon":-70}}}`,
+		},
+		{
+			fromGeohash,
+			`{"geo_distance":{"distance":"200km","pin.location":{"lat":41.12000000663102,"lon":-71.34000012651086}}}`,
+		},
+	}
+
+	for i, test := range tests {
+		q := NewGeoDistanceQuery("pin.location").GeoPoint(test.Point).Distance("200km")
+		src, err := q.Source()
+		if err != nil {
+			t.Fatalf("case #%d: %v", i+1, err)
+		}
+		data, err := json.Marshal(src)
+		if err != nil {
+			t.Fatalf("case #%d: marshaling to JSON failed: %v", i+1, err)
+		}
+		if got := string(data); got != test.Expected {
+			t.Errorf("case #%d: expected\n%s\n,got:\n%s", i+1, test.Expected, got)
+		}
+	}
+}
+
+func TestGeoDistanceQueryWithDistanceTypeAndValidationMethod(t *testing.T) {
+	tests := []struct {
+		DistanceType     string
+		ValidationMethod string
+		Expected         string
+	}{
+		{
+			"arc",
+			"STRICT",
+			`{"geo_distance":{"distance":"12km","distance_type":"arc","pin.location":{"lat":40,"lon":-70},"validation_method":"STRICT"}}`,
+		},
+		{
+			"plane",
+			"COERCE",
+			`{"geo_distance":{"distance":"12km","distance_type":"plane","pin.location":{"lat":40,"lon":-70},"validation_method":"COERCE"}}`,
+		},
+	}
+
+	for i, test := range tests {
+		q := NewGeoDistanceQuery("pin.location").
+			Point(40, -70).
+			Distance("12km").
+			DistanceType(test.DistanceType).
+			ValidationMethod(test.ValidationMethod)
+		src, err := q.Source()
+		if err != nil {
+			t.Fatalf("case #%d: %v", i+1, err)
+		}
+		data, err := json.Marshal(src)
+		if err != nil {
+			t.Fatalf("case #%d: marshaling to JSON failed: %v", i+1, err)
+		}
+		if got := string(data); got != test.Expected {
+			t.Errorf("case #%d: expected\n%s\n,got:\n%s", i+1, test.Expected, got)
+		}
+	}
+}
@@ -43,3 +43,24 @@ func TestHasChildQueryWithInnerHit(t *testing.T) {
 		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
 	}
 }
+
+func TestHasChildQueryWithMinMaxChildren(t *testing.T) {
+	q := NewHasChildQuery("blog_tag", NewTermQuery("tag", "something")).
+		ScoreMode("sum").
+		MinChildren(2).
+		MaxChildren(10).
+		InnerHit(NewInnerHit().Name("tags").Size(3))
+	src, err := q.Source()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(src)
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"has_child":{"inner_hits":{"name":"tags","size":3},"max_children":10,"min_children":2,"query":{"term":{"tag":"something"}},"score_mode":"sum","type":"blog_tag"}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
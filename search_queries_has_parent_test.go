@@ -25,3 +25,22 @@ func TestHasParentQueryTest(t *testing.T) {
 		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
 	}
 }
+
+func TestHasParentQueryWithInnerHit(t *testing.T) {
+	q := NewHasParentQuery("blog", NewTermQuery("tag", "something")).
+		Score(true).
+		InnerHit(NewInnerHit().Name("blogs"))
+	src, err := q.Source()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(src)
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"has_parent":{"inner_hits":{"name":"blogs"},"parent_type":"blog","query":{"term":{"tag":"something"}},"score":true}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
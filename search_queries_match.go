@@ -45,6 +45,9 @@ func NewMatchPhraseQuery(name string, text interface{}) *MatchQuery {
 }
 
 // NewMatchPhrasePrefixQuery creates and initializes a new MatchQuery of type phrase_prefix.
+//
+// Deprecated: Use NewMatchPhrasePrefix instead, which uses the dedicated
+// match_phrase_prefix query.
 func NewMatchPhrasePrefixQuery(name string, text interface{}) *MatchQuery {
 	return &MatchQuery{name: name, text: text, typ: "phrase_prefix"}
 }
@@ -0,0 +1,52 @@
+// Copyright 2012-present Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestNewMatchPhrasePrefix(t *testing.T) {
+	q := NewMatchPhrasePrefix("message", "this is a test")
+	src, err := q.Source()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(src)
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"match_phrase_prefix":{"message":{"query":"this is a test"}}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
+
+func TestMatchPhrasePrefixQueryWithOptions(t *testing.T) {
+	q := NewMatchPhrasePrefix("", nil).
+		Field("message").
+		Query("quick brown f").
+		Analyzer("standard").
+		Slop(2).
+		MaxExpansions(10).
+		ZeroTermsQuery("all").
+		Boost(1.5).
+		QueryName("my_query")
+	src, err := q.Source()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(src)
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"match_phrase_prefix":{"message":{"_name":"my_query","analyzer":"standard","boost":1.5,"max_expansions":10,"query":"quick brown f","slop":2,"zero_terms_query":"all"}}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
@@ -90,6 +90,12 @@ func (q *MoreLikeThisQuery) IgnoreLikeItems(ignoreDocs ...*MoreLikeThisQueryItem
 	return q
 }
 
+// Unlike sets the documents from which the terms should not be selected from.
+// It is an alias for IgnoreLikeItems.
+func (q *MoreLikeThisQuery) Unlike(docs ...*MoreLikeThisQueryItem) *MoreLikeThisQuery {
+	return q.IgnoreLikeItems(docs...)
+}
+
 // Ids sets the document ids to use in order to find documents that are "like" this.
 func (q *MoreLikeThisQuery) Ids(ids ...string) *MoreLikeThisQuery {
 	for _, id := range ids {
@@ -50,6 +50,33 @@ func TestMoreLikeThisQuerySourceWithLikeAndUnlikeItems(t *testing.T) {
 	}
 }
 
+func TestMoreLikeThisQuerySourceWithArtificialDocuments(t *testing.T) {
+	q := NewMoreLikeThisQuery().
+		Field("message").
+		LikeItems(
+			NewMoreLikeThisQueryItem().Index("twitter").Type("tweet").Id("1"),
+			NewMoreLikeThisQueryItem().Index("twitter").Type("tweet").Doc(map[string]interface{}{"message": "Golang and Elasticsearch"}),
+		).
+		Unlike(NewMoreLikeThisQueryItem().Doc(map[string]interface{}{"message": "Java"})).
+		MinTermFreq(1).
+		MaxQueryTerms(12).
+		MinDocFreq(2).
+		MinimumShouldMatch("30%")
+	src, err := q.Source()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := string(data)
+	expected := `{"mlt":{"fields":["message"],"like":[{"_id":"1","_index":"twitter","_type":"tweet"},{"_index":"twitter","_type":"tweet","doc":{"message":"Golang and Elasticsearch"}}],"max_query_terms":12,"min_doc_freq":2,"min_term_freq":1,"minimum_should_match":"30%","unlike":[{"doc":{"message":"Java"}}]}}`
+	if got != expected {
+		t.Fatalf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
+
 func TestMoreLikeThisQuery(t *testing.T) {
 	client := setupTestClientAndCreateIndex(t)
 
@@ -12,12 +12,13 @@ package elastic
 // For more details, see
 // https://www.elastic.co/guide/en/elasticsearch/reference/current/query-dsl-nested-query.html
 type NestedQuery struct {
-	query     Query
-	path      string
-	scoreMode string
-	boost     *float64
-	queryName string
-	innerHit  *InnerHit
+	query          Query
+	path           string
+	scoreMode      string
+	boost          *float64
+	queryName      string
+	innerHit       *InnerHit
+	ignoreUnmapped *bool
 }
 
 // NewNestedQuery creates and initializes a new NestedQuery.
@@ -25,7 +26,9 @@ func NewNestedQuery(path string, query Query) *NestedQuery {
 	return &NestedQuery{path: path, query: query}
 }
 
-// ScoreMode specifies the score mode.
+// ScoreMode specifies how the scores of the matching nested objects
+// affect the score of the root parent document. Allowed values are:
+// avg (the default), max, min, none, or sum.
 func (q *NestedQuery) ScoreMode(scoreMode string) *NestedQuery {
 	q.scoreMode = scoreMode
 	return q
@@ -51,6 +54,14 @@ func (q *NestedQuery) InnerHit(innerHit *InnerHit) *NestedQuery {
 	return q
 }
 
+// IgnoreUnmapped, if true, makes the query match no documents instead of
+// returning an error if the path is not mapped, e.g. when querying
+// multiple indices with different mappings.
+func (q *NestedQuery) IgnoreUnmapped(ignoreUnmapped bool) *NestedQuery {
+	q.ignoreUnmapped = &ignoreUnmapped
+	return q
+}
+
 // Source returns JSON for the query.
 func (q *NestedQuery) Source() (interface{}, error) {
 	query := make(map[string]interface{})
@@ -81,5 +92,8 @@ func (q *NestedQuery) Source() (interface{}, error) {
 		}
 		nq["inner_hits"] = src
 	}
+	if q.ignoreUnmapped != nil {
+		nq["ignore_unmapped"] = *q.ignoreUnmapped
+	}
 	return query, nil
 }
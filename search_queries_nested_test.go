@@ -50,3 +50,23 @@ func TestNestedQueryWithInnerHit(t *testing.T) {
 		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
 	}
 }
+
+func TestNestedQueryWithInnerHitAndIgnoreUnmapped(t *testing.T) {
+	q := NewNestedQuery("obj1", NewTermQuery("obj1.name", "blue")).
+		ScoreMode("max").
+		InnerHit(NewInnerHit().Size(2)).
+		IgnoreUnmapped(true)
+	src, err := q.Source()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(src)
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"nested":{"ignore_unmapped":true,"inner_hits":{"size":2},"path":"obj1","query":{"term":{"obj1.name":"blue"}},"score_mode":"max"}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
@@ -7,6 +7,8 @@ package elastic
 import "errors"
 
 // PercolatorQuery can be used to match queries stored in an index.
+// The document to match is either given inline via Document or Documents,
+// or it is an already indexed document, specified via IndexedDocument.
 //
 // For more details, see
 // https://www.elastic.co/guide/en/elasticsearch/reference/5.x/query-dsl-percolate-query.html
@@ -14,6 +16,7 @@ type PercolatorQuery struct {
 	field                     string
 	documentType              string
 	document                  interface{}
+	documents                 []interface{}
 	indexedDocumentIndex      string
 	indexedDocumentType       string
 	indexedDocumentId         string
@@ -42,6 +45,27 @@ func (q *PercolatorQuery) Document(doc interface{}) *PercolatorQuery {
 	return q
 }
 
+// Documents sets several documents to match at once, which is
+// supported as of Elasticsearch 6.1. It cannot be combined with Document.
+func (q *PercolatorQuery) Documents(docs ...interface{}) *PercolatorQuery {
+	q.documents = append(q.documents, docs...)
+	return q
+}
+
+// IndexedDocument matches an already indexed document, identified by
+// index and id. Version, routing, and preference are optional and
+// ignored if version is 0 or routing and preference are empty.
+func (q *PercolatorQuery) IndexedDocument(index, id string, version int64, routing, preference string) *PercolatorQuery {
+	q.indexedDocumentIndex = index
+	q.indexedDocumentId = id
+	if version > 0 {
+		q.indexedDocumentVersion = &version
+	}
+	q.indexedDocumentRouting = routing
+	q.indexedDocumentPreference = preference
+	return q
+}
+
 func (q *PercolatorQuery) IndexedDocumentIndex(index string) *PercolatorQuery {
 	q.indexedDocumentIndex = index
 	return q
@@ -77,11 +101,12 @@ func (q *PercolatorQuery) Source() (interface{}, error) {
 	if len(q.field) == 0 {
 		return nil, errors.New("elastic: Field is required in PercolatorQuery")
 	}
-	if len(q.documentType) == 0 {
-		return nil, errors.New("elastic: DocumentType is required in PercolatorQuery")
+	if q.document != nil && len(q.documents) > 0 {
+		return nil, errors.New("elastic: Document and Documents are mutually exclusive in PercolatorQuery")
 	}
-	if q.document == nil {
-		return nil, errors.New("elastic: Document is required in PercolatorQuery")
+	indexed := len(q.indexedDocumentIndex) > 0 && len(q.indexedDocumentId) > 0
+	if q.document == nil && len(q.documents) == 0 && !indexed {
+		return nil, errors.New("elastic: Document, Documents, or IndexedDocument is required in PercolatorQuery")
 	}
 
 	// {
@@ -91,8 +116,15 @@ func (q *PercolatorQuery) Source() (interface{}, error) {
 	params := make(map[string]interface{})
 	source["percolate"] = params
 	params["field"] = q.field
-	params["document_type"] = q.documentType
-	params["document"] = q.document
+	if len(q.documentType) > 0 {
+		params["document_type"] = q.documentType
+	}
+	if q.document != nil {
+		params["document"] = q.document
+	}
+	if len(q.documents) > 0 {
+		params["documents"] = q.documents
+	}
 	if len(q.indexedDocumentIndex) > 0 {
 		params["index"] = q.indexedDocumentIndex
 	}
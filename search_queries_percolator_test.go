@@ -66,3 +66,72 @@ func TestPercolatorQueryWithMissingFields(t *testing.T) {
 		t.Fatal("expected error, got nil")
 	}
 }
+
+func TestPercolatorQueryForms(t *testing.T) {
+	tests := []struct {
+		Query    *PercolatorQuery
+		Expected string
+	}{
+		// Inline, single document
+		{
+			NewPercolatorQuery().Field("query").
+				Document(map[string]interface{}{"message": "A new bonsai tree in the office"}),
+			`{"percolate":{"document":{"message":"A new bonsai tree in the office"},"field":"query"}}`,
+		},
+		// Inline, multiple documents
+		{
+			NewPercolatorQuery().Field("query").
+				Documents(
+					map[string]interface{}{"message": "bonsai tree"},
+					map[string]interface{}{"message": "new tree"},
+				),
+			`{"percolate":{"documents":[{"message":"bonsai tree"},{"message":"new tree"}],"field":"query"}}`,
+		},
+		// Indexed document
+		{
+			NewPercolatorQuery().Field("query").
+				IndexedDocument("my-index", "2", 1, "user-1", "_local"),
+			`{"percolate":{"field":"query","id":"2","index":"my-index","preference":"_local","routing":"user-1","version":1}}`,
+		},
+		// Indexed document without optional settings
+		{
+			NewPercolatorQuery().Field("query").IndexedDocument("my-index", "2", 0, "", ""),
+			`{"percolate":{"field":"query","id":"2","index":"my-index"}}`,
+		},
+	}
+
+	for i, test := range tests {
+		src, err := test.Query.Source()
+		if err != nil {
+			t.Fatalf("case #%d: %v", i+1, err)
+		}
+		data, err := json.Marshal(src)
+		if err != nil {
+			t.Fatalf("case #%d: marshaling to JSON failed: %v", i+1, err)
+		}
+		got := string(data)
+		if got != test.Expected {
+			t.Errorf("case #%d: expected\n%s\n,got:\n%s", i+1, test.Expected, got)
+		}
+	}
+}
+
+func TestPercolatorQueryInvalid(t *testing.T) {
+	tests := []*PercolatorQuery{
+		// No document
+		NewPercolatorQuery().Field("query"),
+		// Indexed document without id
+		NewPercolatorQuery().Field("query").IndexedDocumentIndex("my-index"),
+		// Both single and multiple documents
+		NewPercolatorQuery().Field("query").
+			Document(map[string]interface{}{"message": "a"}).
+			Documents(map[string]interface{}{"message": "b"}),
+		// No field
+		NewPercolatorQuery().Document(map[string]interface{}{"message": "a"}),
+	}
+	for i, q := range tests {
+		if _, err := q.Source(); err == nil {
+			t.Errorf("case #%d: expected error, got nil", i+1)
+		}
+	}
+}
@@ -42,6 +42,8 @@ type QueryStringQuery struct {
 	timeZone                  string
 	maxDeterminizedStates     *int
 	escape                    *bool
+	typ                       string
+	fuzzyTranspositions       *bool
 }
 
 // NewQueryStringQuery creates and initializes a new QueryStringQuery.
@@ -82,6 +84,14 @@ func (q *QueryStringQuery) UseDisMax(useDisMax bool) *QueryStringQuery {
 	return q
 }
 
+// Type sets how the query string is executed against multiple fields,
+// i.e. "best_fields" (the default), "most_fields", "cross_fields",
+// "phrase", "phrase_prefix", or "bool_prefix".
+func (q *QueryStringQuery) Type(typ string) *QueryStringQuery {
+	q.typ = typ
+	return q
+}
+
 // TieBreaker is used when more than one field is used with the query string,
 // and combined queries are using dismax.
 func (q *QueryStringQuery) TieBreaker(tieBreaker float64) *QueryStringQuery {
@@ -129,7 +139,15 @@ func (q *QueryStringQuery) AutoGeneratePhraseQueries(autoGeneratePhraseQueries b
 }
 
 // MaxDeterminizedState protects against too-difficult regular expression queries.
+//
+// Deprecated: Use MaxDeterminizedStates instead.
 func (q *QueryStringQuery) MaxDeterminizedState(maxDeterminizedStates int) *QueryStringQuery {
+	return q.MaxDeterminizedStates(maxDeterminizedStates)
+}
+
+// MaxDeterminizedStates protects against too-difficult regular expression
+// queries. It defaults to 10000.
+func (q *QueryStringQuery) MaxDeterminizedStates(maxDeterminizedStates int) *QueryStringQuery {
 	q.maxDeterminizedStates = &maxDeterminizedStates
 	return q
 }
@@ -177,6 +195,13 @@ func (q *QueryStringQuery) FuzzyMaxExpansions(fuzzyMaxExpansions int) *QueryStri
 	return q
 }
 
+// FuzzyTranspositions indicates whether edits for fuzzy matching include
+// transpositions of two adjacent characters (ab → ba). It defaults to true.
+func (q *QueryStringQuery) FuzzyTranspositions(fuzzyTranspositions bool) *QueryStringQuery {
+	q.fuzzyTranspositions = &fuzzyTranspositions
+	return q
+}
+
 func (q *QueryStringQuery) FuzzyRewrite(fuzzyRewrite string) *QueryStringQuery {
 	q.fuzzyRewrite = fuzzyRewrite
 	return q
@@ -279,6 +304,9 @@ func (q *QueryStringQuery) Source() (interface{}, error) {
 		query["fields"] = fields
 	}
 
+	if q.typ != "" {
+		query["type"] = q.typ
+	}
 	if q.tieBreaker != nil {
 		query["tie_breaker"] = *q.tieBreaker
 	}
@@ -324,6 +352,9 @@ func (q *QueryStringQuery) Source() (interface{}, error) {
 	if q.fuzzyRewrite != "" {
 		query["fuzzy_rewrite"] = q.fuzzyRewrite
 	}
+	if q.fuzzyTranspositions != nil {
+		query["fuzzy_transpositions"] = *q.fuzzyTranspositions
+	}
 	if q.phraseSlop != nil {
 		query["phrase_slop"] = *q.phraseSlop
 	}
@@ -44,3 +44,27 @@ func TestQueryStringQueryTimeZone(t *testing.T) {
 		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
 	}
 }
+
+func TestQueryStringQueryCrossFields(t *testing.T) {
+	q := NewQueryStringQuery(`capital of hungary`).
+		Field("city").
+		Field("country").
+		Type("cross_fields").
+		TimeZone("+01:00").
+		FuzzyTranspositions(false).
+		FuzzyMaxExpansions(10).
+		MaxDeterminizedStates(5000)
+	src, err := q.Source()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(src)
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"query_string":{"fields":["city","country"],"fuzzy_max_expansions":10,"fuzzy_transpositions":false,"max_determinized_states":5000,"query":"capital of hungary","time_zone":"+01:00","type":"cross_fields"}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
@@ -30,6 +30,10 @@ type SimpleQueryStringQuery struct {
 	analyzeWildcard        *bool
 	locale                 string
 	queryName              string
+	quoteFieldSuffix       string
+	fuzzyPrefixLength      *int
+	fuzzyMaxExpansions     *int
+	fuzzyTranspositions    *bool
 }
 
 // NewSimpleQueryStringQuery creates and initializes a new SimpleQueryStringQuery.
@@ -79,7 +83,9 @@ func (q *SimpleQueryStringQuery) DefaultOperator(defaultOperator string) *Simple
 	return q
 }
 
-// Flags sets the flags for the query.
+// Flags sets the operators enabled for the query, e.g. "OR|AND|PREFIX".
+// The value is passed to Elasticsearch as is. See the Elasticsearch
+// documentation for the list of available flags.
 func (q *SimpleQueryStringQuery) Flags(flags string) *SimpleQueryStringQuery {
 	q.flags = flags
 	return q
@@ -116,6 +122,35 @@ func (q *SimpleQueryStringQuery) MinimumShouldMatch(minimumShouldMatch string) *
 	return q
 }
 
+// QuoteFieldSuffix is a suffix to append to fields for quoted parts of
+// the query string. This allows to use a field that has a different
+// analysis chain for exact matching.
+func (q *SimpleQueryStringQuery) QuoteFieldSuffix(quoteFieldSuffix string) *SimpleQueryStringQuery {
+	q.quoteFieldSuffix = quoteFieldSuffix
+	return q
+}
+
+// FuzzyPrefixLength sets the number of beginning characters left
+// unchanged for fuzzy matching. It defaults to 0.
+func (q *SimpleQueryStringQuery) FuzzyPrefixLength(fuzzyPrefixLength int) *SimpleQueryStringQuery {
+	q.fuzzyPrefixLength = &fuzzyPrefixLength
+	return q
+}
+
+// FuzzyMaxExpansions sets the maximum number of terms to which the
+// query expands for fuzzy matching. It defaults to 50.
+func (q *SimpleQueryStringQuery) FuzzyMaxExpansions(fuzzyMaxExpansions int) *SimpleQueryStringQuery {
+	q.fuzzyMaxExpansions = &fuzzyMaxExpansions
+	return q
+}
+
+// FuzzyTranspositions indicates whether edits for fuzzy matching include
+// transpositions of two adjacent characters (ab → ba). It defaults to true.
+func (q *SimpleQueryStringQuery) FuzzyTranspositions(fuzzyTranspositions bool) *SimpleQueryStringQuery {
+	q.fuzzyTranspositions = &fuzzyTranspositions
+	return q
+}
+
 // Source returns JSON for the query.
 func (q *SimpleQueryStringQuery) Source() (interface{}, error) {
 	// {
@@ -180,6 +215,18 @@ func (q *SimpleQueryStringQuery) Source() (interface{}, error) {
 	if q.boost != nil {
 		query["boost"] = *q.boost
 	}
+	if q.quoteFieldSuffix != "" {
+		query["quote_field_suffix"] = q.quoteFieldSuffix
+	}
+	if q.fuzzyPrefixLength != nil {
+		query["fuzzy_prefix_length"] = *q.fuzzyPrefixLength
+	}
+	if q.fuzzyMaxExpansions != nil {
+		query["fuzzy_max_expansions"] = *q.fuzzyMaxExpansions
+	}
+	if q.fuzzyTranspositions != nil {
+		query["fuzzy_transpositions"] = *q.fuzzyTranspositions
+	}
 
 	return source, nil
 }
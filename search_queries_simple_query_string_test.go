@@ -28,6 +28,29 @@ func TestSimpleQueryStringQuery(t *testing.T) {
 	}
 }
 
+func TestSimpleQueryStringQueryWithFlags(t *testing.T) {
+	q := NewSimpleQueryStringQuery(`foo | bar + baz*`).
+		Field("body").
+		Flags("OR|AND|PREFIX").
+		QuoteFieldSuffix(".exact").
+		FuzzyPrefixLength(1).
+		FuzzyMaxExpansions(20).
+		FuzzyTranspositions(false)
+	src, err := q.Source()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(src)
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"simple_query_string":{"fields":["body"],"flags":"OR|AND|PREFIX","fuzzy_max_expansions":20,"fuzzy_prefix_length":1,"fuzzy_transpositions":false,"query":"foo | bar + baz*","quote_field_suffix":".exact"}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
+
 func TestSimpleQueryStringQueryExec(t *testing.T) {
 	// client := setupTestClientAndCreateIndexAndLog(t, SetTraceLog(log.New(os.Stdout, "", 0)))
 	client := setupTestClientAndCreateIndex(t)
@@ -0,0 +1,158 @@
+// Copyright 2012-present Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"golang.org/x/net/context"
+)
+
+func TestSearchShardsBuildURL(t *testing.T) {
+	client := setupTestClient(t)
+
+	tests := []struct {
+		Service       *SearchShardsService
+		ExpectedPath  string
+		ExpectedQuery string
+	}{
+		{
+			client.SearchShards(),
+			"/_search_shards",
+			"",
+		},
+		{
+			client.SearchShards("twitter", "gplus"),
+			"/twitter%2Cgplus/_search_shards",
+			"",
+		},
+		{
+			client.SearchShards("twitter").Routing("user1,user2"),
+			"/twitter/_search_shards",
+			"routing=user1%2Cuser2",
+		},
+		{
+			client.SearchShards("twitter").Routing("user1").Preference("_local").Local(true),
+			"/twitter/_search_shards",
+			"local=true&preference=_local&routing=user1",
+		},
+	}
+
+	for i, test := range tests {
+		path, params, err := test.Service.buildURL()
+		if err != nil {
+			t.Fatalf("case #%d: %v", i+1, err)
+		}
+		if want, have := test.ExpectedPath, path; want != have {
+			t.Errorf("case #%d: expected path %q; got: %q", i+1, want, have)
+		}
+		if want, have := test.ExpectedQuery, params.Encode(); want != have {
+			t.Errorf("case #%d: expected query %q; got: %q", i+1, want, have)
+		}
+	}
+}
+
+func TestSearchShardsTwoShards(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if want, have := "/twitter/_search_shards", r.URL.Path; want != have {
+			t.Errorf("expected path %q; got: %q", want, have)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{
+			"nodes": {
+				"JklnKbD7Tyqi9TP3_Q_tBg": {
+					"name": "node-0",
+					"ephemeral_id": "9Cq1PqlqSSWs7I8ZC_IkdQ",
+					"transport_address": "127.0.0.1:9300",
+					"attributes": {}
+				}
+			},
+			"indices": {
+				"twitter": {}
+			},
+			"shards": [
+				[
+					{
+						"index": "twitter",
+						"node": "JklnKbD7Tyqi9TP3_Q_tBg",
+						"primary": true,
+						"shard": 0,
+						"state": "STARTED",
+						"allocation_id": {"id": "0TvkCyF7TAmM1wHP4a42-A"},
+						"relocating_node": null
+					}
+				],
+				[
+					{
+						"index": "twitter",
+						"node": "JklnKbD7Tyqi9TP3_Q_tBg",
+						"primary": true,
+						"shard": 1,
+						"state": "STARTED",
+						"allocation_id": {"id": "fMju3hd1QHWmWrIgFnI4Ww"},
+						"relocating_node": null
+					},
+					{
+						"index": "twitter",
+						"node": null,
+						"primary": false,
+						"shard": 1,
+						"state": "UNASSIGNED",
+						"relocating_node": null
+					}
+				]
+			]
+		}`)
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(SetURL(ts.URL), SetSniff(false), SetHealthcheck(false), SetMaxRetries(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := client.SearchShards("twitter").Routing("user1").Do(context.TODO())
+	if err != nil {
+		t.Fatal(err)
+	}
+	node, ok := res.Nodes["JklnKbD7Tyqi9TP3_Q_tBg"]
+	if !ok {
+		t.Fatal("expected node JklnKbD7Tyqi9TP3_Q_tBg")
+	}
+	if want, have := "127.0.0.1:9300", node.TransportAddress; want != have {
+		t.Errorf("expected transport address %q; got: %q", want, have)
+	}
+	if _, ok := res.Indices["twitter"]; !ok {
+		t.Error("expected index twitter")
+	}
+	if want, have := 2, len(res.Shards); want != have {
+		t.Fatalf("expected %d shard groups; got: %d", want, have)
+	}
+	if want, have := 1, len(res.Shards[0]); want != have {
+		t.Fatalf("expected %d copies of shard 0; got: %d", want, have)
+	}
+	primary := res.Shards[0][0]
+	if !primary.Primary || primary.State != "STARTED" || primary.Node != "JklnKbD7Tyqi9TP3_Q_tBg" {
+		t.Errorf("expected started primary on node JklnKbD7Tyqi9TP3_Q_tBg; got: %+v", primary)
+	}
+	if primary.AllocationId == nil || primary.AllocationId.Id != "0TvkCyF7TAmM1wHP4a42-A" {
+		t.Errorf("expected allocation id 0TvkCyF7TAmM1wHP4a42-A; got: %+v", primary.AllocationId)
+	}
+	if want, have := 2, len(res.Shards[1]); want != have {
+		t.Fatalf("expected %d copies of shard 1; got: %d", want, have)
+	}
+	replica := res.Shards[1][1]
+	if replica.Primary {
+		t.Error("expected replica copy of shard 1")
+	}
+	if want, have := 1, replica.Shard; want != have {
+		t.Errorf("expected shard %d; got: %d", want, have)
+	}
+	if want, have := "UNASSIGNED", replica.State; want != have {
+		t.Errorf("expected state %q; got: %q", want, have)
+	}
+}
@@ -25,6 +25,7 @@ type SearchSource struct {
 	terminateAfter           *int
 	storedFieldNames         []string
 	docvalueFields           []string
+	fields                   []string
 	scriptFields             []*ScriptField
 	fetchSourceContext       *FetchSourceContext
 	aggregations             map[string]Aggregation
@@ -36,6 +37,9 @@ type SearchSource struct {
 	indexBoosts              map[string]float64
 	stats                    []string
 	innerHits                map[string]*InnerHit
+	collapse                 *CollapseBuilder
+	profile                  bool
+	knn                      *KnnSearch
 }
 
 // NewSearchSource initializes a new SearchSource.
@@ -99,6 +103,13 @@ func (s *SearchSource) Explain(explain bool) *SearchSource {
 	return s
 }
 
+// Profile specifies that this search source should activate the
+// Profile API for queries and aggregations made on it.
+func (s *SearchSource) Profile(profile bool) *SearchSource {
+	s.profile = profile
+	return s
+}
+
 // Version indicates whether each search hit should be returned with
 // a version associated to it.
 func (s *SearchSource) Version(version bool) *SearchSource {
@@ -260,6 +271,14 @@ func (s *SearchSource) DocvalueFields(docvalueFields ...string) *SearchSource {
 	return s
 }
 
+// Fields adds one or more fields to retrieve from the mapping and return
+// in the "fields" section of each search hit. It is commonly combined
+// with FetchSource(false) to skip loading the _source entirely.
+func (s *SearchSource) Fields(fields ...string) *SearchSource {
+	s.fields = append(s.fields, fields...)
+	return s
+}
+
 // ScriptField adds a single script field with the provided script.
 func (s *SearchSource) ScriptField(scriptField *ScriptField) *SearchSource {
 	s.scriptFields = append(s.scriptFields, scriptField)
@@ -291,6 +310,18 @@ func (s *SearchSource) InnerHit(name string, innerHit *InnerHit) *SearchSource {
 	return s
 }
 
+// Collapse adds field collapsing.
+func (s *SearchSource) Collapse(collapse *CollapseBuilder) *SearchSource {
+	s.collapse = collapse
+	return s
+}
+
+// Knn adds a top-level approximate k-nearest neighbor search.
+func (s *SearchSource) Knn(knn *KnnSearch) *SearchSource {
+	s.knn = knn
+	return s
+}
+
 // Source returns the serializable JSON for the source builder.
 func (s *SearchSource) Source() (interface{}, error) {
 	source := make(map[string]interface{})
@@ -337,6 +368,9 @@ func (s *SearchSource) Source() (interface{}, error) {
 	if s.explain != nil {
 		source["explain"] = *s.explain
 	}
+	if s.profile {
+		source["profile"] = s.profile
+	}
 	if s.fetchSourceContext != nil {
 		src, err := s.fetchSourceContext.Source()
 		if err != nil {
@@ -358,6 +392,10 @@ func (s *SearchSource) Source() (interface{}, error) {
 		source["docvalue_fields"] = s.docvalueFields
 	}
 
+	if len(s.fields) > 0 {
+		source["fields"] = s.fields
+	}
+
 	if len(s.scriptFields) > 0 {
 		sfmap := make(map[string]interface{})
 		for _, scriptField := range s.scriptFields {
@@ -459,6 +497,22 @@ func (s *SearchSource) Source() (interface{}, error) {
 		source["stats"] = s.stats
 	}
 
+	if s.collapse != nil {
+		src, err := s.collapse.Source()
+		if err != nil {
+			return nil, err
+		}
+		source["collapse"] = src
+	}
+
+	if s.knn != nil {
+		src, err := s.knn.Source()
+		if err != nil {
+			return nil, err
+		}
+		source["knn"] = src
+	}
+
 	if len(s.innerHits) > 0 {
 		// Top-level inner hits
 		// See http://www.elastic.co/guide/en/elasticsearch/reference/1.5/search-request-inner-hits.html#top-level-inner-hits
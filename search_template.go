@@ -0,0 +1,236 @@
+// Copyright 2012-present Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"golang.org/x/net/context"
+
+	"gopkg.in/olivere/elastic.v5/uritemplates"
+)
+
+// SearchTemplateService executes a search with a search template, either
+// stored on the server and referenced by id, or specified inline.
+// See https://www.elastic.co/guide/en/elasticsearch/reference/current/search-template.html
+type SearchTemplateService struct {
+	client            *Client
+	pretty            bool
+	index             []string
+	id                string
+	source            interface{}
+	params            map[string]interface{}
+	profile           *bool
+	explain           *bool
+	searchType        string
+	routing           string
+	preference        string
+	ignoreUnavailable *bool
+	allowNoIndices    *bool
+	expandWildcards   string
+}
+
+// NewSearchTemplateService creates a new SearchTemplateService.
+func NewSearchTemplateService(client *Client) *SearchTemplateService {
+	return &SearchTemplateService{
+		client: client,
+	}
+}
+
+// Index sets the names of the indices to search.
+func (s *SearchTemplateService) Index(indices ...string) *SearchTemplateService {
+	s.index = append(s.index, indices...)
+	return s
+}
+
+// Id is the id of a stored search template to execute.
+func (s *SearchTemplateService) Id(id string) *SearchTemplateService {
+	s.id = id
+	return s
+}
+
+// Source is an inline search template to execute. It can be a string or
+// anything that serializes to a JSON object.
+func (s *SearchTemplateService) Source(source interface{}) *SearchTemplateService {
+	s.source = source
+	return s
+}
+
+// Params are the parameters used to render the template.
+func (s *SearchTemplateService) Params(params map[string]interface{}) *SearchTemplateService {
+	s.params = params
+	return s
+}
+
+// Param sets a single parameter used to render the template.
+func (s *SearchTemplateService) Param(name string, value interface{}) *SearchTemplateService {
+	if s.params == nil {
+		s.params = make(map[string]interface{})
+	}
+	s.params[name] = value
+	return s
+}
+
+// Profile indicates whether to return detailed timing information
+// about the execution of the search.
+func (s *SearchTemplateService) Profile(profile bool) *SearchTemplateService {
+	s.profile = &profile
+	return s
+}
+
+// Explain indicates whether to return an explanation of the score
+// computation for each hit.
+func (s *SearchTemplateService) Explain(explain bool) *SearchTemplateService {
+	s.explain = &explain
+	return s
+}
+
+// SearchType sets the search operation type, e.g. "query_then_fetch"
+// or "dfs_query_then_fetch".
+func (s *SearchTemplateService) SearchType(searchType string) *SearchTemplateService {
+	s.searchType = searchType
+	return s
+}
+
+// Routing is a comma-separated list of specific routing values.
+func (s *SearchTemplateService) Routing(routing string) *SearchTemplateService {
+	s.routing = routing
+	return s
+}
+
+// Preference specifies the node or shard the operation should be performed on (default: random).
+func (s *SearchTemplateService) Preference(preference string) *SearchTemplateService {
+	s.preference = preference
+	return s
+}
+
+// IgnoreUnavailable indicates whether the specified concrete indices
+// should be ignored when unavailable (missing or closed).
+func (s *SearchTemplateService) IgnoreUnavailable(ignoreUnavailable bool) *SearchTemplateService {
+	s.ignoreUnavailable = &ignoreUnavailable
+	return s
+}
+
+// AllowNoIndices indicates whether to ignore if a wildcard indices
+// expression resolves into no concrete indices. (This includes `_all`
+// string or when no indices have been specified).
+func (s *SearchTemplateService) AllowNoIndices(allowNoIndices bool) *SearchTemplateService {
+	s.allowNoIndices = &allowNoIndices
+	return s
+}
+
+// ExpandWildcards indicates whether to expand wildcard expression to
+// concrete indices that are open, closed or both.
+func (s *SearchTemplateService) ExpandWildcards(expandWildcards string) *SearchTemplateService {
+	s.expandWildcards = expandWildcards
+	return s
+}
+
+// Pretty indicates that the JSON response be indented and human readable.
+func (s *SearchTemplateService) Pretty(pretty bool) *SearchTemplateService {
+	s.pretty = pretty
+	return s
+}
+
+// buildURL builds the URL for the operation.
+func (s *SearchTemplateService) buildURL() (string, url.Values, error) {
+	var err error
+	var path string
+	// Build URL
+	if len(s.index) > 0 {
+		path, err = uritemplates.Expand("/{index}/_search/template", map[string]string{
+			"index": strings.Join(s.index, ","),
+		})
+	} else {
+		path = "/_search/template"
+	}
+	if err != nil {
+		return "", url.Values{}, err
+	}
+
+	// Add query string parameters
+	params := url.Values{}
+	if s.pretty {
+		params.Set("pretty", "1")
+	}
+	if s.searchType != "" {
+		params.Set("search_type", s.searchType)
+	}
+	if s.routing != "" {
+		params.Set("routing", s.routing)
+	}
+	if s.preference != "" {
+		params.Set("preference", s.preference)
+	}
+	if s.ignoreUnavailable != nil {
+		params.Set("ignore_unavailable", fmt.Sprintf("%v", *s.ignoreUnavailable))
+	}
+	if s.allowNoIndices != nil {
+		params.Set("allow_no_indices", fmt.Sprintf("%v", *s.allowNoIndices))
+	}
+	if s.expandWildcards != "" {
+		params.Set("expand_wildcards", s.expandWildcards)
+	}
+	return path, params, nil
+}
+
+// Validate checks if the operation is valid.
+func (s *SearchTemplateService) Validate() error {
+	if s.id == "" && s.source == nil {
+		return errors.New("elastic: either Id or Source is required in SearchTemplateService")
+	}
+	if s.id != "" && s.source != nil {
+		return errors.New("elastic: Id and Source are mutually exclusive in SearchTemplateService")
+	}
+	return nil
+}
+
+// getBody returns the body of the request.
+func (s *SearchTemplateService) getBody() interface{} {
+	body := make(map[string]interface{})
+	if s.id != "" {
+		body["id"] = s.id
+	} else {
+		body["source"] = s.source
+	}
+	if len(s.params) > 0 {
+		body["params"] = s.params
+	}
+	if s.profile != nil {
+		body["profile"] = *s.profile
+	}
+	if s.explain != nil {
+		body["explain"] = *s.explain
+	}
+	return body
+}
+
+// Do executes the search and returns a SearchResult.
+func (s *SearchTemplateService) Do(ctx context.Context) (*SearchResult, error) {
+	// Check pre-conditions
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+
+	// Get URL for request
+	path, params, err := s.buildURL()
+	if err != nil {
+		return nil, err
+	}
+
+	// Perform request
+	ret := new(SearchResult)
+	res, err := s.client.performRequest(ctx, "POST", path, params, s.getBody(), ret)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return search results
+	ret.Header = res.Header
+	return ret, nil
+}
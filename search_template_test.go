@@ -0,0 +1,147 @@
+// Copyright 2012-present Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"golang.org/x/net/context"
+)
+
+const testSearchTemplateResponse = `{
+	"took": 3,
+	"timed_out": false,
+	"_shards": {"total": 1, "successful": 1, "failed": 0},
+	"hits": {
+		"total": 1,
+		"max_score": 1.3,
+		"hits": [
+			{"_index": "twitter", "_type": "tweet", "_id": "1", "_score": 1.3, "_source": {"user": "olivere"}}
+		]
+	}
+}`
+
+func TestSearchTemplateBuildURL(t *testing.T) {
+	client := setupTestClient(t)
+
+	tests := []struct {
+		Service       *SearchTemplateService
+		ExpectedPath  string
+		ExpectedQuery string
+	}{
+		{
+			client.SearchTemplate(),
+			"/_search/template",
+			"",
+		},
+		{
+			client.SearchTemplate("twitter", "blog"),
+			"/twitter%2Cblog/_search/template",
+			"",
+		},
+		{
+			client.SearchTemplate("twitter").Routing("kimchy").SearchType("dfs_query_then_fetch"),
+			"/twitter/_search/template",
+			"routing=kimchy&search_type=dfs_query_then_fetch",
+		},
+	}
+
+	for i, test := range tests {
+		path, params, err := test.Service.buildURL()
+		if err != nil {
+			t.Fatalf("case #%d: %v", i+1, err)
+		}
+		if want, have := test.ExpectedPath, path; want != have {
+			t.Errorf("case #%d: expected path %q; got: %q", i+1, want, have)
+		}
+		if want, have := test.ExpectedQuery, params.Encode(); want != have {
+			t.Errorf("case #%d: expected query %q; got: %q", i+1, want, have)
+		}
+	}
+}
+
+func TestSearchTemplateValidate(t *testing.T) {
+	client := setupTestClient(t)
+
+	if err := client.SearchTemplate("twitter").Validate(); err == nil {
+		t.Fatal("expected error when neither id nor source is given")
+	}
+	if err := client.SearchTemplate("twitter").Id("my-template").Source(`{"query":{"match_all":{}}}`).Validate(); err == nil {
+		t.Fatal("expected error when both id and source are given")
+	}
+	if err := client.SearchTemplate("twitter").Id("my-template").Validate(); err != nil {
+		t.Fatalf("expected no error; got: %v", err)
+	}
+}
+
+func TestSearchTemplateDo(t *testing.T) {
+	var path, body string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			t.Error(err)
+		}
+		path, body = r.URL.Path, string(data)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, testSearchTemplateResponse)
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(SetURL(ts.URL), SetSniff(false), SetHealthcheck(false), SetMaxRetries(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		Service      *SearchTemplateService
+		ExpectedPath string
+		ExpectedBody string
+	}{
+		// Stored template
+		{
+			client.SearchTemplate("twitter").
+				Id("my-template").
+				Params(map[string]interface{}{"user": "olivere", "size": 10}),
+			"/twitter/_search/template",
+			`{"id":"my-template","params":{"size":10,"user":"olivere"}}`,
+		},
+		// Inline template
+		{
+			client.SearchTemplate().
+				Source(map[string]interface{}{
+					"query": map[string]interface{}{
+						"term": map[string]interface{}{"user": "{{user}}"},
+					},
+				}).
+				Param("user", "olivere").
+				Profile(true),
+			"/_search/template",
+			`{"params":{"user":"olivere"},"profile":true,"source":{"query":{"term":{"user":"{{user}}"}}}}`,
+		},
+	}
+
+	for i, test := range tests {
+		res, err := test.Service.Do(context.TODO())
+		if err != nil {
+			t.Fatalf("case #%d: %v", i+1, err)
+		}
+		if want, have := test.ExpectedPath, path; want != have {
+			t.Errorf("case #%d: expected path %q; got: %q", i+1, want, have)
+		}
+		if want, have := test.ExpectedBody, body; want != have {
+			t.Errorf("case #%d: expected body %s; got: %s", i+1, want, have)
+		}
+		if want, have := int64(1), res.TotalHits(); want != have {
+			t.Errorf("case #%d: expected %d hits; got: %d", i+1, want, have)
+		}
+		if want, have := "1", res.Hits.Hits[0].Id; want != have {
+			t.Errorf("case #%d: expected hit %q; got: %q", i+1, want, have)
+		}
+	}
+}
@@ -6,6 +6,9 @@ package elastic
 
 import (
 	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"reflect"
 	"testing"
 	"time"
@@ -1023,3 +1026,435 @@ func TestSearchBuildURL(t *testing.T) {
 		}
 	}
 }
+
+func TestSearchResultFieldsWithoutSource(t *testing.T) {
+	s := `{
+	"took": 2,
+	"timed_out": false,
+	"hits": {
+		"total": 1,
+		"max_score": 1.0,
+		"hits": [{
+			"_index": "twitter",
+			"_type": "tweet",
+			"_id": "1",
+			"_score": 1.0,
+			"fields": {
+				"user": ["olivere"],
+				"retweets": [108]
+			}
+		}]
+	}
+}`
+	var res SearchResult
+	if err := json.Unmarshal([]byte(s), &res); err != nil {
+		t.Fatal(err)
+	}
+	if res.Hits == nil || len(res.Hits.Hits) != 1 {
+		t.Fatalf("expected 1 hit; got: %v", res.Hits)
+	}
+	hit := res.Hits.Hits[0]
+	if hit.Source != nil {
+		t.Errorf("expected no source; got: %s", string(*hit.Source))
+	}
+	users, ok := hit.Fields["user"].([]interface{})
+	if !ok {
+		t.Fatalf("expected fields to contain %q; got: %v", "user", hit.Fields)
+	}
+	if len(users) != 1 || users[0] != "olivere" {
+		t.Errorf("expected user field %v; got: %v", []interface{}{"olivere"}, users)
+	}
+	if _, ok := hit.Fields["retweets"]; !ok {
+		t.Errorf("expected fields to contain %q; got: %v", "retweets", hit.Fields)
+	}
+
+	// Each must skip hits without a source
+	var aTweet tweet
+	if got := len(res.Each(reflect.TypeOf(aTweet))); got != 0 {
+		t.Errorf("expected Each to skip hits without source; got: %d", got)
+	}
+}
+
+func TestSearchResultTotalHitsShapes(t *testing.T) {
+	tests := []struct {
+		Body     string
+		Expected int64
+	}{
+		{
+			Body:     `{"hits":{"total":42,"max_score":1.0,"hits":[]}}`,
+			Expected: 42,
+		},
+		{
+			Body:     `{"hits":{"total":{"value":42,"relation":"eq"},"max_score":1.0,"hits":[]}}`,
+			Expected: 42,
+		},
+		{
+			Body:     `{"hits":{"total":{"value":10000,"relation":"gte"},"max_score":null,"hits":[]}}`,
+			Expected: 10000,
+		},
+		{
+			Body:     `{"hits":{"max_score":null,"hits":[]}}`,
+			Expected: 0,
+		},
+	}
+
+	for i, test := range tests {
+		var res SearchResult
+		if err := json.Unmarshal([]byte(test.Body), &res); err != nil {
+			t.Fatalf("case #%d: %v", i+1, err)
+		}
+		if want, have := test.Expected, res.TotalHits(); want != have {
+			t.Errorf("case #%d: expected %d hits; got: %d", i+1, want, have)
+		}
+	}
+}
+
+func TestDecodeHitStrict(t *testing.T) {
+	type user struct {
+		Name string `json:"name"`
+	}
+	type doc struct {
+		User     user   `json:"user"`
+		Message  string `json:"message"`
+		Retweets int    `json:"retweets"`
+	}
+	source := json.RawMessage(`{"user":{"name":"olivere"},"message":"Welcome to Golang and Elasticsearch."}`)
+	hit := &SearchHit{Id: "1", Source: &source}
+
+	// All required fields present
+	var d doc
+	if err := DecodeHitStrict(hit, &d, "message", "user.name"); err != nil {
+		t.Fatalf("expected no error; got: %v", err)
+	}
+	if want, have := "olivere", d.User.Name; want != have {
+		t.Errorf("expected user name = %q; got: %q", want, have)
+	}
+
+	// Required field excluded by source filtering
+	err := DecodeHitStrict(hit, &d, "message", "retweets")
+	if err == nil {
+		t.Fatal("expected error for missing field")
+	}
+	if want, have := `elastic: hit "1" is missing required fields in source: [retweets]`, err.Error(); want != have {
+		t.Errorf("expected error %q; got: %q", want, have)
+	}
+	if err := DecodeHitStrict(hit, &d, "user.email"); err == nil {
+		t.Fatal("expected error for missing nested field")
+	}
+
+	// No source at all
+	if err := DecodeHitStrict(&SearchHit{Id: "2"}, &d); err == nil {
+		t.Fatal("expected error for hit without source")
+	}
+}
+
+func TestSearchHitUnmarshalSource(t *testing.T) {
+	source := json.RawMessage(`{"user":"olivere","message":"Welcome to Golang and Elasticsearch.","retweets":108}`)
+	hit := &SearchHit{Id: "1", Source: &source}
+
+	var tw tweet
+	if err := hit.UnmarshalSource(&tw); err != nil {
+		t.Fatal(err)
+	}
+	if want, have := "olivere", tw.User; want != have {
+		t.Errorf("expected user = %q; got: %q", want, have)
+	}
+	if want, have := 108, tw.Retweets; want != have {
+		t.Errorf("expected retweets = %d; got: %d", want, have)
+	}
+
+	// No source
+	if err := (&SearchHit{Id: "2"}).UnmarshalSource(&tw); err != ErrNoSource {
+		t.Errorf("expected ErrNoSource; got: %v", err)
+	}
+	var nilHit *SearchHit
+	if err := nilHit.UnmarshalSource(&tw); err != ErrNoSource {
+		t.Errorf("expected ErrNoSource; got: %v", err)
+	}
+}
+
+func TestSearchResultEachHit(t *testing.T) {
+	source1 := json.RawMessage(`{"user":"olivere","message":"Welcome to Golang and Elasticsearch."}`)
+	source2 := json.RawMessage(`{"user":"sandrae","message":"Cycling is fun."}`)
+	searchResult := &SearchResult{
+		Hits: &SearchHits{
+			TotalHits: 3,
+			Hits: []*SearchHit{
+				{Id: "1", Source: &source1},
+				{Id: "2"}, // no source
+				{Id: "3", Source: &source2},
+			},
+		},
+	}
+
+	var tweets []tweet
+	err := searchResult.EachHit(func(hit *SearchHit) error {
+		var tw tweet
+		if err := hit.UnmarshalSource(&tw); err != nil {
+			return err
+		}
+		tweets = append(tweets, tw)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, have := 2, len(tweets); want != have {
+		t.Fatalf("expected %d tweets; got: %d", want, have)
+	}
+	if want, have := "sandrae", tweets[1].User; want != have {
+		t.Errorf("expected user = %q; got: %q", want, have)
+	}
+
+	// Stops at the first error
+	count := 0
+	errStop := fmt.Errorf("stop")
+	err = searchResult.EachHit(func(hit *SearchHit) error {
+		count++
+		return errStop
+	})
+	if err != errStop {
+		t.Errorf("expected error %v; got: %v", errStop, err)
+	}
+	if want, have := 1, count; want != have {
+		t.Errorf("expected %d calls; got: %d", want, have)
+	}
+
+	// Does not iterate when no hits are found
+	searchResult = &SearchResult{Hits: nil}
+	err = searchResult.EachHit(func(hit *SearchHit) error {
+		t.Fatal("expected no call")
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSearchResultClusters(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if want, have := "/twitter,cluster_one:twitter,cluster_two:twitter/_search", r.URL.Path; want != have {
+			t.Errorf("expected path %q; got: %q", want, have)
+		}
+		if want, have := "true", r.URL.Query().Get("ccs_minimize_roundtrips"); want != have {
+			t.Errorf("expected ccs_minimize_roundtrips=%q; got: %q", want, have)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{
+			"took": 42,
+			"timed_out": false,
+			"_shards": {"total": 6, "successful": 6, "skipped": 2, "failed": 0},
+			"_clusters": {
+				"total": 3,
+				"successful": 2,
+				"skipped": 1,
+				"details": {
+					"(local)": {
+						"status": "successful",
+						"indices": "twitter",
+						"took": 21,
+						"timed_out": false,
+						"_shards": {"total": 3, "successful": 3, "skipped": 1, "failed": 0}
+					},
+					"cluster_one": {
+						"status": "successful",
+						"indices": "twitter",
+						"took": 38,
+						"timed_out": false,
+						"_shards": {"total": 3, "successful": 3, "skipped": 1, "failed": 0}
+					},
+					"cluster_two": {
+						"status": "skipped",
+						"indices": "twitter",
+						"timed_out": false,
+						"failures": [
+							{
+								"shard": -1,
+								"index": null,
+								"reason": {
+									"type": "connect_transport_exception",
+									"reason": "[cluster_two][10.0.0.3:9300] connect_timeout[30s]"
+								}
+							}
+						]
+					}
+				}
+			},
+			"hits": {"total": 1, "max_score": 1.0, "hits": [
+				{"_index": "cluster_one:twitter", "_type": "tweet", "_id": "1", "_score": 1.0, "_source": {"user": "olivere"}}
+			]}
+		}`)
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(SetURL(ts.URL), SetSniff(false), SetHealthcheck(false), SetMaxRetries(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := client.Search("twitter", "cluster_one:twitter", "cluster_two:twitter").
+		CCSMinimizeRoundtrips(true).
+		Query(NewMatchAllQuery()).
+		Do(context.TODO())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Shards == nil {
+		t.Fatal("expected Shards != nil")
+	}
+	if want, have := 2, res.Shards.Skipped; want != have {
+		t.Errorf("expected %d skipped shards; got: %d", want, have)
+	}
+	if res.Clusters == nil {
+		t.Fatal("expected Clusters != nil")
+	}
+	if want, have := 3, res.Clusters.Total; want != have {
+		t.Errorf("expected %d total clusters; got: %d", want, have)
+	}
+	if want, have := 2, res.Clusters.Successful; want != have {
+		t.Errorf("expected %d successful clusters; got: %d", want, have)
+	}
+	if want, have := 1, res.Clusters.Skipped; want != have {
+		t.Errorf("expected %d skipped clusters; got: %d", want, have)
+	}
+	if want, have := 3, len(res.Clusters.Details); want != have {
+		t.Fatalf("expected details of %d clusters; got: %d", want, have)
+	}
+	local, ok := res.Clusters.Details["(local)"]
+	if !ok {
+		t.Fatal("expected details of local cluster")
+	}
+	if want, have := int64(21), local.TookInMillis; want != have {
+		t.Errorf("expected took = %d; got: %d", want, have)
+	}
+	if local.Shards == nil || local.Shards.Skipped != 1 {
+		t.Errorf("expected 1 skipped shard on local cluster; got: %+v", local.Shards)
+	}
+	skipped, ok := res.Clusters.Details["cluster_two"]
+	if !ok {
+		t.Fatal("expected details of cluster_two")
+	}
+	if want, have := "skipped", skipped.Status; want != have {
+		t.Errorf("expected status %q; got: %q", want, have)
+	}
+	if want, have := 1, len(skipped.Failures); want != have {
+		t.Fatalf("expected %d failures; got: %d", want, have)
+	}
+	if skipped.Failures[0].Reason == nil || skipped.Failures[0].Reason.Type != "connect_transport_exception" {
+		t.Errorf("expected failure of type connect_transport_exception; got: %+v", skipped.Failures[0].Reason)
+	}
+}
+
+func TestSearchResultWithoutClusters(t *testing.T) {
+	var res SearchResult
+	if err := json.Unmarshal([]byte(`{"took":1,"_shards":{"total":5,"successful":5,"failed":0},"hits":{"total":0,"hits":[]}}`), &res); err != nil {
+		t.Fatal(err)
+	}
+	if res.Clusters != nil {
+		t.Errorf("expected Clusters == nil; got: %+v", res.Clusters)
+	}
+	if want, have := 0, res.Shards.Skipped; want != have {
+		t.Errorf("expected %d skipped shards; got: %d", want, have)
+	}
+}
+
+func TestSearchResultHeader(t *testing.T) {
+	warning := `299 Elasticsearch-6.8.0 "[types removal] Specifying types in search requests is deprecated."`
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Elastic-Product", "Elasticsearch")
+		w.Header().Add("Warning", warning)
+		fmt.Fprint(w, `{"took":42,"timed_out":false,"hits":{"total":0,"hits":[]}}`)
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(SetURL(ts.URL), SetSniff(false), SetHealthcheck(false), SetMaxRetries(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := client.Search().Index("twitter").Type("tweet").Query(NewMatchAllQuery()).Do(context.TODO())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Header == nil {
+		t.Fatal("expected Header != nil")
+	}
+	if want, have := warning, res.Header.Get("Warning"); want != have {
+		t.Errorf("expected Warning header %q; got: %q", want, have)
+	}
+	if want, have := "Elasticsearch", res.Header.Get("X-Elastic-Product"); want != have {
+		t.Errorf("expected X-Elastic-Product header %q; got: %q", want, have)
+	}
+	if want, have := int64(42), res.TookInMillis; want != have {
+		t.Errorf("expected TookInMillis = %d; got: %d", want, have)
+	}
+}
+
+func TestSearchResultShardFailures(t *testing.T) {
+	body := `{
+		"took": 5,
+		"timed_out": false,
+		"_shards": {
+			"total": 5,
+			"successful": 3,
+			"skipped": 0,
+			"failed": 2,
+			"failures": [
+				{
+					"shard": 1,
+					"index": "twitter",
+					"node": "n1",
+					"reason": {"type": "query_shard_exception", "reason": "failed to create query", "index": "twitter"}
+				},
+				{
+					"shard": 3,
+					"index": "twitter",
+					"node": "n2",
+					"status": "INTERNAL_SERVER_ERROR",
+					"reason": {"type": "illegal_argument_exception", "reason": "Fielddata is disabled on text fields"}
+				}
+			]
+		},
+		"hits": {"total": 0, "hits": []}
+	}`
+	var res SearchResult
+	if err := json.Unmarshal([]byte(body), &res); err != nil {
+		t.Fatal(err)
+	}
+	if !res.HasShardFailures() {
+		t.Fatal("expected shard failures")
+	}
+	if want, have := 2, res.Shards.Failed; want != have {
+		t.Errorf("expected %d failed shards; got: %d", want, have)
+	}
+	if want, have := 2, len(res.Shards.Failures); want != have {
+		t.Fatalf("expected %d shard failures; got: %d", want, have)
+	}
+	expected := []struct {
+		Index, Node, Type, Reason string
+		Shard                     int
+	}{
+		{"twitter", "n1", "query_shard_exception", "failed to create query", 1},
+		{"twitter", "n2", "illegal_argument_exception", "Fielddata is disabled on text fields", 3},
+	}
+	for i, want := range expected {
+		f := res.Shards.Failures[i]
+		if f.Index != want.Index || f.Node != want.Node || f.Shard != want.Shard {
+			t.Errorf("failure #%d: expected %s/%d on %s; got: %s/%d on %s", i, want.Index, want.Shard, want.Node, f.Index, f.Shard, f.Node)
+		}
+		if f.Reason == nil {
+			t.Fatalf("failure #%d: expected reason", i)
+		}
+		if f.Reason.Type != want.Type || f.Reason.Reason != want.Reason {
+			t.Errorf("failure #%d: expected reason %s (%s); got: %s (%s)", i, want.Type, want.Reason, f.Reason.Type, f.Reason.Reason)
+		}
+	}
+
+	// No failures
+	res = SearchResult{}
+	if err := json.Unmarshal([]byte(`{"_shards":{"total":5,"successful":5,"failed":0}}`), &res); err != nil {
+		t.Fatal(err)
+	}
+	if res.HasShardFailures() {
+		t.Error("expected no shard failures")
+	}
+}
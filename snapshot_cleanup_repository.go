@@ -0,0 +1,132 @@
+// Copyright 2012-present Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+import (
+	"fmt"
+	"net/url"
+
+	"golang.org/x/net/context"
+
+	"gopkg.in/olivere/elastic.v5/uritemplates"
+)
+
+// SnapshotCleanupRepositoryService removes stale data from a snapshot
+// repository, i.e. data that is no longer referenced by any snapshot.
+// See https://www.elastic.co/guide/en/elasticsearch/reference/7.4/clean-up-snapshot-repo-api.html
+// for details.
+type SnapshotCleanupRepositoryService struct {
+	client        *Client
+	pretty        bool
+	repository    string
+	masterTimeout string
+	timeout       string
+}
+
+// NewSnapshotCleanupRepositoryService creates a new SnapshotCleanupRepositoryService.
+func NewSnapshotCleanupRepositoryService(client *Client) *SnapshotCleanupRepositoryService {
+	return &SnapshotCleanupRepositoryService{
+		client: client,
+	}
+}
+
+// Repository specifies the repository name.
+func (s *SnapshotCleanupRepositoryService) Repository(repository string) *SnapshotCleanupRepositoryService {
+	s.repository = repository
+	return s
+}
+
+// MasterTimeout specifies the explicit operation timeout for connection to master node.
+func (s *SnapshotCleanupRepositoryService) MasterTimeout(masterTimeout string) *SnapshotCleanupRepositoryService {
+	s.masterTimeout = masterTimeout
+	return s
+}
+
+// Timeout is an explicit operation timeout.
+func (s *SnapshotCleanupRepositoryService) Timeout(timeout string) *SnapshotCleanupRepositoryService {
+	s.timeout = timeout
+	return s
+}
+
+// Pretty indicates that the JSON response be indented and human readable.
+func (s *SnapshotCleanupRepositoryService) Pretty(pretty bool) *SnapshotCleanupRepositoryService {
+	s.pretty = pretty
+	return s
+}
+
+// buildURL builds the URL for the operation.
+func (s *SnapshotCleanupRepositoryService) buildURL() (string, url.Values, error) {
+	// Build URL
+	path, err := uritemplates.Expand("/_snapshot/{repository}/_cleanup", map[string]string{
+		"repository": s.repository,
+	})
+	if err != nil {
+		return "", url.Values{}, err
+	}
+
+	// Add query string parameters
+	params := url.Values{}
+	if s.pretty {
+		params.Set("pretty", "1")
+	}
+	if s.masterTimeout != "" {
+		params.Set("master_timeout", s.masterTimeout)
+	}
+	if s.timeout != "" {
+		params.Set("timeout", s.timeout)
+	}
+	return path, params, nil
+}
+
+// Validate checks if the operation is valid.
+func (s *SnapshotCleanupRepositoryService) Validate() error {
+	var invalid []string
+	if s.repository == "" {
+		invalid = append(invalid, "Repository")
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf("missing required fields: %v", invalid)
+	}
+	return nil
+}
+
+// Do executes the operation.
+func (s *SnapshotCleanupRepositoryService) Do(ctx context.Context) (*SnapshotCleanupRepositoryResponse, error) {
+	// Check pre-conditions
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+
+	// Get URL for request
+	path, params, err := s.buildURL()
+	if err != nil {
+		return nil, err
+	}
+
+	// Get HTTP response
+	res, err := s.client.PerformRequest(ctx, "POST", path, params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return operation response
+	ret := new(SnapshotCleanupRepositoryResponse)
+	if err := s.client.decoder.Decode(res.Body, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// SnapshotCleanupRepositoryResponse is the response of SnapshotCleanupRepositoryService.Do.
+type SnapshotCleanupRepositoryResponse struct {
+	Results SnapshotCleanupRepositoryResults `json:"results"`
+}
+
+// SnapshotCleanupRepositoryResults reports what has been removed
+// from the repository.
+type SnapshotCleanupRepositoryResults struct {
+	DeletedBytes int64 `json:"deleted_bytes"`
+	DeletedBlobs int64 `json:"deleted_blobs"`
+}
@@ -0,0 +1,53 @@
+// Copyright 2012-present Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestSnapshotCleanupRepositoryURL(t *testing.T) {
+	client := setupTestClient(t)
+
+	tests := []struct {
+		Repository string
+		Expected   string
+	}{
+		{
+			"repo",
+			"/_snapshot/repo/_cleanup",
+		},
+	}
+
+	for _, test := range tests {
+		path, _, err := client.SnapshotCleanupRepository(test.Repository).buildURL()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if path != test.Expected {
+			t.Errorf("expected %q; got: %q", test.Expected, path)
+		}
+	}
+}
+
+func TestSnapshotCleanupRepositoryResponse(t *testing.T) {
+	body := `{
+		"results": {
+			"deleted_bytes": 20,
+			"deleted_blobs": 5
+		}
+	}`
+	var res SnapshotCleanupRepositoryResponse
+	if err := json.Unmarshal([]byte(body), &res); err != nil {
+		t.Fatal(err)
+	}
+	if want, have := int64(20), res.Results.DeletedBytes; want != have {
+		t.Errorf("expected deleted_bytes = %d; got: %d", want, have)
+	}
+	if want, have := int64(5), res.Results.DeletedBlobs; want != have {
+		t.Errorf("expected deleted_blobs = %d; got: %d", want, have)
+	}
+}
@@ -0,0 +1,196 @@
+// Copyright 2012-present Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"golang.org/x/net/context"
+
+	"gopkg.in/olivere/elastic.v5/uritemplates"
+)
+
+// SnapshotStatusService returns information about the status of snapshots.
+// Without a repository, it returns all currently running snapshots.
+// See https://www.elastic.co/guide/en/elasticsearch/reference/5.1/modules-snapshots.html#_snapshot_status
+// for details.
+type SnapshotStatusService struct {
+	client            *Client
+	pretty            bool
+	repository        string
+	snapshot          []string
+	masterTimeout     string
+	ignoreUnavailable *bool
+}
+
+// NewSnapshotStatusService creates a new SnapshotStatusService.
+func NewSnapshotStatusService(client *Client) *SnapshotStatusService {
+	return &SnapshotStatusService{
+		client:   client,
+		snapshot: make([]string, 0),
+	}
+}
+
+// Repository is the repository name.
+func (s *SnapshotStatusService) Repository(repository string) *SnapshotStatusService {
+	s.repository = repository
+	return s
+}
+
+// Snapshot is the list of snapshot names. It requires a repository.
+func (s *SnapshotStatusService) Snapshot(snapshots ...string) *SnapshotStatusService {
+	s.snapshot = append(s.snapshot, snapshots...)
+	return s
+}
+
+// MasterTimeout specifies an explicit operation timeout for connection to master node.
+func (s *SnapshotStatusService) MasterTimeout(masterTimeout string) *SnapshotStatusService {
+	s.masterTimeout = masterTimeout
+	return s
+}
+
+// IgnoreUnavailable specifies whether to ignore unavailable snapshots,
+// defaults to false which means a SnapshotMissingException is thrown.
+func (s *SnapshotStatusService) IgnoreUnavailable(ignoreUnavailable bool) *SnapshotStatusService {
+	s.ignoreUnavailable = &ignoreUnavailable
+	return s
+}
+
+// Pretty indicates that the JSON response be indented and human readable.
+func (s *SnapshotStatusService) Pretty(pretty bool) *SnapshotStatusService {
+	s.pretty = pretty
+	return s
+}
+
+// buildURL builds the URL for the operation.
+func (s *SnapshotStatusService) buildURL() (string, url.Values, error) {
+	var err error
+	var path string
+
+	// Build URL
+	if s.repository != "" && len(s.snapshot) > 0 {
+		path, err = uritemplates.Expand("/_snapshot/{repository}/{snapshot}/_status", map[string]string{
+			"repository": s.repository,
+			"snapshot":   strings.Join(s.snapshot, ","),
+		})
+	} else if s.repository != "" {
+		path, err = uritemplates.Expand("/_snapshot/{repository}/_status", map[string]string{
+			"repository": s.repository,
+		})
+	} else {
+		path = "/_snapshot/_status"
+	}
+	if err != nil {
+		return "", url.Values{}, err
+	}
+
+	// Add query string parameters
+	params := url.Values{}
+	if s.pretty {
+		params.Set("pretty", "1")
+	}
+	if s.masterTimeout != "" {
+		params.Set("master_timeout", s.masterTimeout)
+	}
+	if s.ignoreUnavailable != nil {
+		params.Set("ignore_unavailable", fmt.Sprintf("%v", *s.ignoreUnavailable))
+	}
+	return path, params, nil
+}
+
+// Validate checks if the operation is valid.
+func (s *SnapshotStatusService) Validate() error {
+	if len(s.snapshot) > 0 && s.repository == "" {
+		return fmt.Errorf("missing required fields: %v", []string{"Repository"})
+	}
+	return nil
+}
+
+// Do executes the operation.
+func (s *SnapshotStatusService) Do(ctx context.Context) (*SnapshotStatusResponse, error) {
+	// Check pre-conditions
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+
+	// Get URL for request
+	path, params, err := s.buildURL()
+	if err != nil {
+		return nil, err
+	}
+
+	// Get HTTP response
+	res, err := s.client.PerformRequest(ctx, "GET", path, params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return operation response
+	ret := new(SnapshotStatusResponse)
+	if err := s.client.decoder.Decode(res.Body, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// SnapshotStatusResponse is the response of SnapshotStatusService.Do.
+type SnapshotStatusResponse struct {
+	Snapshots []SnapshotStatus `json:"snapshots"`
+}
+
+// SnapshotStatus is the status of a single snapshot.
+type SnapshotStatus struct {
+	Snapshot           string                         `json:"snapshot"`
+	Repository         string                         `json:"repository"`
+	UUID               string                         `json:"uuid"`
+	State              string                         `json:"state"` // e.g. IN_PROGRESS, SUCCESS, FAILED
+	IncludeGlobalState bool                           `json:"include_global_state"`
+	ShardsStats        SnapshotShardsStats            `json:"shards_stats"`
+	Stats              SnapshotStats                  `json:"stats"`
+	Indices            map[string]SnapshotIndexStatus `json:"indices"`
+}
+
+// SnapshotShardsStats counts the shards of a snapshot by their stage.
+type SnapshotShardsStats struct {
+	Initializing int `json:"initializing"`
+	Started      int `json:"started"`
+	Finalizing   int `json:"finalizing"`
+	Done         int `json:"done"`
+	Failed       int `json:"failed"`
+	Total        int `json:"total"`
+}
+
+// SnapshotStats reports file counts and sizes of a snapshot, an index,
+// or a shard.
+type SnapshotStats struct {
+	Incremental       SnapshotStatsFiles `json:"incremental"`
+	Processed         SnapshotStatsFiles `json:"processed"`
+	Total             SnapshotStatsFiles `json:"total"`
+	StartTimeInMillis int64              `json:"start_time_in_millis"`
+	TimeInMillis      int64              `json:"time_in_millis"`
+}
+
+// SnapshotStatsFiles is the number and size of files in SnapshotStats.
+type SnapshotStatsFiles struct {
+	FileCount   int   `json:"file_count"`
+	SizeInBytes int64 `json:"size_in_bytes"`
+}
+
+// SnapshotIndexStatus is the status of a single index of a snapshot.
+type SnapshotIndexStatus struct {
+	ShardsStats SnapshotShardsStats                 `json:"shards_stats"`
+	Stats       SnapshotStats                       `json:"stats"`
+	Shards      map[string]SnapshotIndexShardStatus `json:"shards"`
+}
+
+// SnapshotIndexShardStatus is the status of a single shard of a snapshot.
+type SnapshotIndexShardStatus struct {
+	Stage  string        `json:"stage"` // e.g. INIT, STARTED, FINALIZE, DONE, FAILURE
+	Stats  SnapshotStats `json:"stats"`
+	Node   string        `json:"node,omitempty"`
+	Reason string        `json:"reason,omitempty"`
+}
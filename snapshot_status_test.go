@@ -0,0 +1,163 @@
+// Copyright 2012-present Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestSnapshotStatusURL(t *testing.T) {
+	client := setupTestClient(t)
+
+	tests := []struct {
+		Repository string
+		Snapshot   []string
+		Expected   string
+	}{
+		{
+			"",
+			[]string{},
+			"/_snapshot/_status",
+		},
+		{
+			"repo",
+			[]string{},
+			"/_snapshot/repo/_status",
+		},
+		{
+			"repo",
+			[]string{"snap1"},
+			"/_snapshot/repo/snap1/_status",
+		},
+		{
+			"repo",
+			[]string{"snap1", "snap2"},
+			"/_snapshot/repo/snap1%2Csnap2/_status",
+		},
+	}
+
+	for i, test := range tests {
+		path, _, err := client.SnapshotStatus().Repository(test.Repository).Snapshot(test.Snapshot...).buildURL()
+		if err != nil {
+			t.Errorf("case #%d: %v", i+1, err)
+			continue
+		}
+		if path != test.Expected {
+			t.Errorf("case #%d: expected %q; got: %q", i+1, test.Expected, path)
+		}
+	}
+
+	_, params, err := client.SnapshotStatus().Repository("repo").IgnoreUnavailable(true).buildURL()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, have := "true", params.Get("ignore_unavailable"); want != have {
+		t.Errorf("expected ignore_unavailable = %q; got: %q", want, have)
+	}
+
+	if err := client.SnapshotStatus().Snapshot("snap1").Validate(); err == nil {
+		t.Error("expected Validate to fail with snapshot but without repository")
+	}
+}
+
+func TestSnapshotStatusResponse(t *testing.T) {
+	body := `{
+		"snapshots": [{
+			"snapshot": "snap1",
+			"repository": "repo",
+			"uuid": "XuBo4l4ISYiVg0nYUen9zg",
+			"state": "IN_PROGRESS",
+			"include_global_state": true,
+			"shards_stats": {
+				"initializing": 0,
+				"started": 1,
+				"finalizing": 0,
+				"done": 4,
+				"failed": 0,
+				"total": 5
+			},
+			"stats": {
+				"incremental": { "file_count": 8, "size_in_bytes": 4704 },
+				"processed": { "file_count": 7, "size_in_bytes": 4254 },
+				"total": { "file_count": 8, "size_in_bytes": 4704 },
+				"start_time_in_millis": 1526280280355,
+				"time_in_millis": 358
+			},
+			"indices": {
+				"twitter": {
+					"shards_stats": {
+						"initializing": 0,
+						"started": 1,
+						"finalizing": 0,
+						"done": 4,
+						"failed": 0,
+						"total": 5
+					},
+					"stats": {
+						"incremental": { "file_count": 8, "size_in_bytes": 4704 },
+						"processed": { "file_count": 7, "size_in_bytes": 4254 },
+						"total": { "file_count": 8, "size_in_bytes": 4704 },
+						"start_time_in_millis": 1526280280355,
+						"time_in_millis": 358
+					},
+					"shards": {
+						"0": {
+							"stage": "STARTED",
+							"stats": {
+								"incremental": { "file_count": 2, "size_in_bytes": 1176 },
+								"processed": { "file_count": 1, "size_in_bytes": 726 },
+								"total": { "file_count": 2, "size_in_bytes": 1176 },
+								"start_time_in_millis": 1526280280355,
+								"time_in_millis": 120
+							},
+							"node": "DKDsT6mZSmyTPmPt1sYbTQ"
+						}
+					}
+				}
+			}
+		}]
+	}`
+	var res SnapshotStatusResponse
+	if err := json.Unmarshal([]byte(body), &res); err != nil {
+		t.Fatal(err)
+	}
+	if want, have := 1, len(res.Snapshots); want != have {
+		t.Fatalf("expected %d snapshots; got: %d", want, have)
+	}
+	snap := res.Snapshots[0]
+	if want, have := "IN_PROGRESS", snap.State; want != have {
+		t.Errorf("expected state %q; got: %q", want, have)
+	}
+	if want, have := 5, snap.ShardsStats.Total; want != have {
+		t.Errorf("expected shards_stats.total = %d; got: %d", want, have)
+	}
+	if want, have := 4, snap.ShardsStats.Done; want != have {
+		t.Errorf("expected shards_stats.done = %d; got: %d", want, have)
+	}
+	index, found := snap.Indices["twitter"]
+	if !found {
+		t.Fatalf("expected index %q; got: %v", "twitter", snap.Indices)
+	}
+	if want, have := 5, index.ShardsStats.Total; want != have {
+		t.Errorf("expected index shards_stats.total = %d; got: %d", want, have)
+	}
+	if want, have := 4, index.ShardsStats.Done; want != have {
+		t.Errorf("expected index shards_stats.done = %d; got: %d", want, have)
+	}
+	if want, have := int64(4704), index.Stats.Total.SizeInBytes; want != have {
+		t.Errorf("expected index stats.total.size_in_bytes = %d; got: %d", want, have)
+	}
+	shard, found := index.Shards["0"]
+	if !found {
+		t.Fatalf("expected shard %q; got: %v", "0", index.Shards)
+	}
+	if want, have := "STARTED", shard.Stage; want != have {
+		t.Errorf("expected shard stage %q; got: %q", want, have)
+	}
+	if want, have := int64(726), shard.Stats.Processed.SizeInBytes; want != have {
+		t.Errorf("expected shard stats.processed.size_in_bytes = %d; got: %d", want, have)
+	}
+}
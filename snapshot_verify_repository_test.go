@@ -0,0 +1,65 @@
+// Copyright 2012-present Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestSnapshotVerifyRepositoryURL(t *testing.T) {
+	client := setupTestClient(t)
+
+	tests := []struct {
+		Repository string
+		Expected   string
+	}{
+		{
+			"repo",
+			"/_snapshot/repo/_verify",
+		},
+	}
+
+	for _, test := range tests {
+		path, _, err := client.SnapshotVerifyRepository(test.Repository).buildURL()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if path != test.Expected {
+			t.Errorf("expected %q; got: %q", test.Expected, path)
+		}
+	}
+}
+
+func TestSnapshotVerifyRepositoryValidate(t *testing.T) {
+	client := setupTestClient(t)
+
+	if err := client.SnapshotVerifyRepository("").Validate(); err == nil {
+		t.Fatal("expected Validate to fail without repository")
+	}
+}
+
+func TestSnapshotVerifyRepositoryResponse(t *testing.T) {
+	body := `{
+		"nodes": {
+			"Rt6Q3Vd4SXGN0dF5DdyIBg": { "name": "node-1" },
+			"k3hNxfpYQa2GsZz8z8dBfA": { "name": "node-2" }
+		}
+	}`
+	var res SnapshotVerifyRepositoryResponse
+	if err := json.Unmarshal([]byte(body), &res); err != nil {
+		t.Fatal(err)
+	}
+	if want, have := 2, len(res.Nodes); want != have {
+		t.Fatalf("expected %d nodes; got: %d", want, have)
+	}
+	node, found := res.Nodes["Rt6Q3Vd4SXGN0dF5DdyIBg"]
+	if !found || node == nil {
+		t.Fatalf("expected node %q; got: %v", "Rt6Q3Vd4SXGN0dF5DdyIBg", res.Nodes)
+	}
+	if want, have := "node-1", node.Name; want != have {
+		t.Errorf("expected node name %q; got: %q", want, have)
+	}
+}
@@ -131,6 +131,8 @@ type FieldSort struct {
 	sortMode       *string
 	nestedFilter   Query
 	nestedPath     *string
+	nestedSort     *NestedSort
+	format         *string
 }
 
 // NewFieldSort creates a new FieldSort.
@@ -209,6 +211,34 @@ func (s *FieldSort) NestedPath(nestedPath string) *FieldSort {
 	return s
 }
 
+// Mode is an alias for SortMode. Possible values are:
+// min, max, sum, avg, and median.
+func (s *FieldSort) Mode(mode string) *FieldSort {
+	return s.SortMode(mode)
+}
+
+// Nested sorts on a field inside the nested object at path. Only nested
+// objects matching the (optional) filter are taken into account.
+// It is a shortcut for NestedSort(NewNestedSort(path).Filter(filter)).
+func (s *FieldSort) Nested(path string, filter Query) *FieldSort {
+	return s.NestedSort(NewNestedSort(path).Filter(filter))
+}
+
+// NestedSort is used if sorting occurs on a field that is inside a
+// nested object. It supersedes NestedPath and NestedFilter.
+func (s *FieldSort) NestedSort(nestedSort *NestedSort) *FieldSort {
+	s.nestedSort = nestedSort
+	return s
+}
+
+// Format specifies the format of the sort values returned for date
+// fields, e.g. "epoch_millis" or "strict_date_optional_time".
+// It requires Elasticsearch 6.8 or later.
+func (s *FieldSort) Format(format string) *FieldSort {
+	s.format = &format
+	return s
+}
+
 // Source returns the JSON-serializable data.
 func (s *FieldSort) Source() (interface{}, error) {
 	source := make(map[string]interface{})
@@ -241,6 +271,79 @@ func (s *FieldSort) Source() (interface{}, error) {
 	if s.nestedPath != nil {
 		x["nested_path"] = *s.nestedPath
 	}
+	if s.nestedSort != nil {
+		src, err := s.nestedSort.Source()
+		if err != nil {
+			return nil, err
+		}
+		x["nested"] = src
+	}
+	if s.format != nil {
+		x["format"] = *s.format
+	}
+	return source, nil
+}
+
+// -- NestedSort --
+
+// NestedSort specifies the nested object to use when sorting on a field
+// inside a nested object.
+// See https://www.elastic.co/guide/en/elasticsearch/reference/current/sort-search-results.html#nested-sorting.
+type NestedSort struct {
+	path        string
+	filter      Query
+	maxChildren *int
+	nestedSort  *NestedSort
+}
+
+// NewNestedSort creates a new NestedSort for the nested object at path.
+func NewNestedSort(path string) *NestedSort {
+	return &NestedSort{path: path}
+}
+
+// Filter sets a filter that nested objects should match with
+// in order to be taken into account for sorting.
+func (s *NestedSort) Filter(filter Query) *NestedSort {
+	s.filter = filter
+	return s
+}
+
+// MaxChildren sets the maximum number of children to consider per
+// root document when picking the sort value.
+func (s *NestedSort) MaxChildren(maxChildren int) *NestedSort {
+	s.maxChildren = &maxChildren
+	return s
+}
+
+// NestedSort sets the nested sort for an object nested inside this one.
+func (s *NestedSort) NestedSort(nestedSort *NestedSort) *NestedSort {
+	s.nestedSort = nestedSort
+	return s
+}
+
+// Source returns the JSON-serializable data.
+func (s *NestedSort) Source() (interface{}, error) {
+	source := make(map[string]interface{})
+	if s.path != "" {
+		source["path"] = s.path
+	}
+	if s.filter != nil {
+		src, err := s.filter.Source()
+		if err != nil {
+			return nil, err
+		}
+		source["filter"] = src
+	}
+	if s.maxChildren != nil {
+		source["max_children"] = *s.maxChildren
+	}
+	if s.nestedSort != nil {
+		src, err := s.nestedSort.Source()
+		if err != nil {
+			return nil, err
+		}
+		source["nested"] = src
+	}
 	return source, nil
 }
 
@@ -321,6 +424,11 @@ func (s *GeoDistanceSort) GeoDistance(geoDistance string) *GeoDistanceSort {
 	return s
 }
 
+// DistanceType is an alias for GeoDistance.
+func (s *GeoDistanceSort) DistanceType(distanceType string) *GeoDistanceSort {
+	return s.GeoDistance(distanceType)
+}
+
 // Unit specifies the distance unit to use. It defaults to km.
 // See http://www.elasticsearch.org/guide/en/elasticsearch/reference/current/common-options.html#distance-units
 // for details.
@@ -337,6 +445,11 @@ func (s *GeoDistanceSort) SortMode(sortMode string) *GeoDistanceSort {
 	return s
 }
 
+// Mode is an alias for SortMode.
+func (s *GeoDistanceSort) Mode(mode string) *GeoDistanceSort {
+	return s.SortMode(mode)
+}
+
 // NestedFilter sets a filter that nested objects should match with
 // in order to be taken into account for sorting.
 func (s *GeoDistanceSort) NestedFilter(nestedFilter Query) *GeoDistanceSort {
@@ -374,8 +487,10 @@ func (s *GeoDistanceSort) Source() (interface{}, error) {
 		x["distance_type"] = *s.geoDistance
 	}
 
-	if !s.ascending {
-		x["reverse"] = true
+	if s.ascending {
+		x["order"] = "asc"
+	} else {
+		x["order"] = "desc"
 	}
 	if s.sortMode != nil {
 		x["mode"] = *s.sortMode
@@ -418,6 +533,12 @@ func NewScriptSort(script *Script, typ string) *ScriptSort {
 	}
 }
 
+// Script sets the script to compute the sort value.
+func (s *ScriptSort) Script(script *Script) *ScriptSort {
+	s.script = script
+	return s
+}
+
 // Type sets the script type, which can be either "string" or "number".
 func (s *ScriptSort) Type(typ string) *ScriptSort {
 	s.typ = typ
@@ -450,6 +571,11 @@ func (s *ScriptSort) SortMode(sortMode string) *ScriptSort {
 	return s
 }
 
+// Mode is an alias for SortMode.
+func (s *ScriptSort) Mode(mode string) *ScriptSort {
+	return s.SortMode(mode)
+}
+
 // NestedFilter sets a filter that nested objects should match with
 // in order to be taken into account for sorting.
 func (s *ScriptSort) NestedFilter(nestedFilter Query) *ScriptSort {
@@ -481,8 +607,10 @@ func (s *ScriptSort) Source() (interface{}, error) {
 
 	x["type"] = s.typ
 
-	if !s.ascending {
-		x["reverse"] = true
+	if s.ascending {
+		x["order"] = "asc"
+	} else {
+		x["order"] = "desc"
 	}
 	if s.sortMode != nil {
 		x["mode"] = *s.sortMode
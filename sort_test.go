@@ -138,6 +138,23 @@ func TestFieldSortOrderDesc(t *testing.T) {
 	}
 }
 
+func TestFieldSortWithDateFormat(t *testing.T) {
+	builder := NewFieldSort("created").Desc().Format("epoch_millis")
+	src, err := builder.Source()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(src)
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"created":{"format":"epoch_millis","order":"desc"}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
+
 func TestFieldSortComplex(t *testing.T) {
 	builder := NewFieldSort("price").Desc().
 		SortMode("avg").
@@ -160,6 +177,50 @@ func TestFieldSortComplex(t *testing.T) {
 	}
 }
 
+func TestFieldSortWithNestedFilterAndMaxMode(t *testing.T) {
+	builder := NewFieldSort("offer.price").
+		Asc().
+		Mode("max").
+		Nested("offer", NewTermQuery("offer.color", "blue"))
+	src, err := builder.Source()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(src)
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"offer.price":{"mode":"max","nested":{"filter":{"term":{"offer.color":"blue"}},"path":"offer"},"order":"asc"}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
+
+func TestFieldSortWithMultiLevelNestedSort(t *testing.T) {
+	builder := NewFieldSort("parent.child.age").
+		Desc().
+		SortMode("min").
+		NestedSort(
+			NewNestedSort("parent").
+				Filter(NewRangeQuery("parent.age").Gte(21)).
+				NestedSort(NewNestedSort("parent.child").MaxChildren(5)),
+		)
+	src, err := builder.Source()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(src)
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"parent.child.age":{"mode":"min","nested":{"filter":{"range":{"parent.age":{"from":21,"include_lower":true,"include_upper":true,"to":null}}},"nested":{"max_children":5,"path":"parent.child"},"path":"parent"},"order":"desc"}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
+
 func TestGeoDistanceSort(t *testing.T) {
 	builder := NewGeoDistanceSort("pin.location").
 		Point(-70, 40).
@@ -176,7 +237,7 @@ func TestGeoDistanceSort(t *testing.T) {
 		t.Fatalf("marshaling to JSON failed: %v", err)
 	}
 	got := string(data)
-	expected := `{"_geo_distance":{"distance_type":"sloppy_arc","mode":"min","pin.location":[{"lat":-70,"lon":40}],"unit":"km"}}`
+	expected := `{"_geo_distance":{"distance_type":"sloppy_arc","mode":"min","order":"asc","pin.location":[{"lat":-70,"lon":40}],"unit":"km"}}`
 	if got != expected {
 		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
 	}
@@ -198,11 +259,35 @@ func TestGeoDistanceSortOrderDesc(t *testing.T) {
 		t.Fatalf("marshaling to JSON failed: %v", err)
 	}
 	got := string(data)
-	expected := `{"_geo_distance":{"distance_type":"sloppy_arc","mode":"min","pin.location":[{"lat":-70,"lon":40}],"reverse":true,"unit":"km"}}`
+	expected := `{"_geo_distance":{"distance_type":"sloppy_arc","mode":"min","order":"desc","pin.location":[{"lat":-70,"lon":40}],"unit":"km"}}`
 	if got != expected {
 		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
 	}
 }
+
+func TestGeoDistanceSortWithMultiplePoints(t *testing.T) {
+	builder := NewGeoDistanceSort("pin.location").
+		Points(GeoPointFromLatLon(40, -70), GeoPointFromLatLon(41, -71)).
+		GeoHashes("drm3btev3e86").
+		DistanceType("arc").
+		Mode("max").
+		Unit("mi").
+		Desc()
+	src, err := builder.Source()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(src)
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"_geo_distance":{"distance_type":"arc","mode":"max","order":"desc","pin.location":[{"lat":40,"lon":-70},{"lat":41,"lon":-71},"drm3btev3e86"],"unit":"mi"}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
+
 func TestScriptSort(t *testing.T) {
 	builder := NewScriptSort(NewScript("doc['field_name'].value * factor").Param("factor", 1.1), "number").Order(true)
 	src, err := builder.Source()
@@ -214,7 +299,7 @@ func TestScriptSort(t *testing.T) {
 		t.Fatalf("marshaling to JSON failed: %v", err)
 	}
 	got := string(data)
-	expected := `{"_script":{"script":{"inline":"doc['field_name'].value * factor","params":{"factor":1.1}},"type":"number"}}`
+	expected := `{"_script":{"order":"asc","script":{"inline":"doc['field_name'].value * factor","params":{"factor":1.1}},"type":"number"}}`
 	if got != expected {
 		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
 	}
@@ -231,7 +316,28 @@ func TestScriptSortOrderDesc(t *testing.T) {
 		t.Fatalf("marshaling to JSON failed: %v", err)
 	}
 	got := string(data)
-	expected := `{"_script":{"reverse":true,"script":{"inline":"doc['field_name'].value * factor","params":{"factor":1.1}},"type":"number"}}`
+	expected := `{"_script":{"order":"desc","script":{"inline":"doc['field_name'].value * factor","params":{"factor":1.1}},"type":"number"}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
+
+func TestScriptSortWithStringType(t *testing.T) {
+	builder := NewScriptSort(nil, "").
+		Script(NewScript("doc['first'].value + ' ' + doc['last'].value").Lang("painless")).
+		Type("string").
+		Mode("min").
+		Desc()
+	src, err := builder.Source()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(src)
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"_script":{"mode":"min","order":"desc","script":{"inline":"doc['first'].value + ' ' + doc['last'].value","lang":"painless"},"type":"string"}}`
 	if got != expected {
 		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
 	}
@@ -5,6 +5,7 @@
 package elastic
 
 import (
+	"errors"
 	"fmt"
 	"net/url"
 	"strings"
@@ -20,13 +21,14 @@ import (
 // See http://www.elastic.co/guide/en/elasticsearch/reference/master/tasks-cancel.html
 // for details.
 type TasksCancelService struct {
-	client     *Client
-	pretty     bool
-	taskId     *int64
-	actions    []string
-	nodeId     []string
-	parentNode string
-	parentTask *int64
+	client       *Client
+	pretty       bool
+	taskId       string
+	actions      []string
+	nodeId       []string
+	parentNode   string
+	parentTask   *int64
+	parentTaskId string
 }
 
 // NewTasksCancelService creates a new TasksCancelService.
@@ -39,8 +41,23 @@ func NewTasksCancelService(client *Client) *TasksCancelService {
 }
 
 // TaskId specifies the task to cancel. Set to -1 to cancel all tasks.
+// Use TaskIdString or TaskIdFromNodeAndId to specify a task id in the
+// node_id:task_number format returned by Elasticsearch 5.x.
 func (s *TasksCancelService) TaskId(taskId int64) *TasksCancelService {
-	s.taskId = &taskId
+	s.taskId = fmt.Sprintf("%d", taskId)
+	return s
+}
+
+// TaskIdString specifies the task to cancel, e.g. "oTUltX4IQMOUUVeiohTt8A:12345".
+// Leave empty to cancel all tasks matching the other filters.
+func (s *TasksCancelService) TaskIdString(taskId string) *TasksCancelService {
+	s.taskId = taskId
+	return s
+}
+
+// TaskIdFromNodeAndId specifies the task to cancel by its node and id.
+func (s *TasksCancelService) TaskIdFromNodeAndId(nodeId string, id int64) *TasksCancelService {
+	s.taskId = fmt.Sprintf("%s:%d", nodeId, id)
 	return s
 }
 
@@ -50,6 +67,13 @@ func (s *TasksCancelService) Actions(actions []string) *TasksCancelService {
 	return s
 }
 
+// AddActions adds one or more actions that should be cancelled,
+// e.g. "*reindex".
+func (s *TasksCancelService) AddActions(actions ...string) *TasksCancelService {
+	s.actions = append(s.actions, actions...)
+	return s
+}
+
 // NodeId is a list of node IDs or names to limit the returned information;
 // use `_local` to return information from the node you're connecting to,
 // leave empty to get information from all nodes.
@@ -58,6 +82,12 @@ func (s *TasksCancelService) NodeId(nodeId []string) *TasksCancelService {
 	return s
 }
 
+// Nodes is a list of node IDs or names to limit the cancelled tasks to.
+func (s *TasksCancelService) Nodes(nodes ...string) *TasksCancelService {
+	s.nodeId = append(s.nodeId, nodes...)
+	return s
+}
+
 // ParentNode specifies to cancel tasks with specified parent node.
 func (s *TasksCancelService) ParentNode(parentNode string) *TasksCancelService {
 	s.parentNode = parentNode
@@ -65,12 +95,19 @@ func (s *TasksCancelService) ParentNode(parentNode string) *TasksCancelService {
 }
 
 // ParentTask specifies to cancel tasks with specified parent task id.
-// Set to -1 to cancel all.
+// Set to -1 to cancel all. It cannot be combined with ParentTaskId.
 func (s *TasksCancelService) ParentTask(parentTask int64) *TasksCancelService {
 	s.parentTask = &parentTask
 	return s
 }
 
+// ParentTaskId specifies to cancel tasks with specified parent task id
+// (node_id:task_number). It cannot be combined with ParentTask.
+func (s *TasksCancelService) ParentTaskId(parentTaskId string) *TasksCancelService {
+	s.parentTaskId = parentTaskId
+	return s
+}
+
 // Pretty indicates that the JSON response be indented and human readable.
 func (s *TasksCancelService) Pretty(pretty bool) *TasksCancelService {
 	s.pretty = pretty
@@ -82,9 +119,9 @@ func (s *TasksCancelService) buildURL() (string, url.Values, error) {
 	// Build URL
 	var err error
 	var path string
-	if s.taskId != nil {
+	if s.taskId != "" {
 		path, err = uritemplates.Expand("/_tasks/{task_id}/_cancel", map[string]string{
-			"task_id": fmt.Sprintf("%d", *s.taskId),
+			"task_id": s.taskId,
 		})
 	} else {
 		path = "/_tasks/_cancel"
@@ -110,11 +147,17 @@ func (s *TasksCancelService) buildURL() (string, url.Values, error) {
 	if s.parentTask != nil {
 		params.Set("parent_task", fmt.Sprintf("%v", *s.parentTask))
 	}
+	if s.parentTaskId != "" {
+		params.Set("parent_task_id", s.parentTaskId)
+	}
 	return path, params, nil
 }
 
 // Validate checks if the operation is valid.
 func (s *TasksCancelService) Validate() error {
+	if s.parentTask != nil && s.parentTaskId != "" {
+		return errors.New("elastic: ParentTask and ParentTaskId are mutually exclusive in TasksCancelService")
+	}
 	return nil
 }
 
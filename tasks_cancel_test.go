@@ -4,7 +4,11 @@
 
 package elastic
 
-import "testing"
+import (
+	"encoding/json"
+	"net/url"
+	"testing"
+)
 
 func TestTasksCancelBuildURL(t *testing.T) {
 	client := setupTestClient(t)
@@ -28,6 +32,123 @@ func TestTasksCancelBuildURL(t *testing.T) {
 	if got != want {
 		t.Errorf("want %q; got %q", want, got)
 	}
+
+	// Cancel specific task by its string id
+	got, _, err = client.TasksCancel().TaskIdString("oTUltX4IQMOUUVeiohTt8A:42").buildURL()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want = "/_tasks/oTUltX4IQMOUUVeiohTt8A%3A42/_cancel"
+	if got != want {
+		t.Errorf("want %q; got %q", want, got)
+	}
+
+	// Cancel specific task by node and id
+	got, _, err = client.TasksCancel().TaskIdFromNodeAndId("oTUltX4IQMOUUVeiohTt8A", 42).buildURL()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != want {
+		t.Errorf("want %q; got %q", want, got)
+	}
+}
+
+func TestTasksCancelBuildURLWithFilters(t *testing.T) {
+	client := setupTestClient(t)
+
+	got, params, err := client.TasksCancel().
+		Nodes("node1", "node2").
+		AddActions("*reindex").
+		ParentTaskId("oTUltX4IQMOUUVeiohTt8A:12").
+		buildURL()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "/_tasks/_cancel"
+	if got != want {
+		t.Errorf("want %q; got %q", want, got)
+	}
+	expected := url.Values{
+		"node_id":        []string{"node1,node2"},
+		"actions":        []string{"*reindex"},
+		"parent_task_id": []string{"oTUltX4IQMOUUVeiohTt8A:12"},
+	}
+	if params.Encode() != expected.Encode() {
+		t.Errorf("want %v; got %v", expected, params)
+	}
+}
+
+func TestTasksCancelBuildURLWithActions(t *testing.T) {
+	client := setupTestClient(t)
+
+	_, params, err := client.TasksCancel().Actions([]string{"*reindex", "*byquery"}).buildURL()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, have := "*reindex,*byquery", params.Get("actions"); want != have {
+		t.Errorf("want %q; got %q", want, have)
+	}
+
+	_, params, err = client.TasksCancel().Actions([]string{"*reindex"}).AddActions("*byquery").buildURL()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, have := "*reindex,*byquery", params.Get("actions"); want != have {
+		t.Errorf("want %q; got %q", want, have)
+	}
+}
+
+func TestTasksCancelValidate(t *testing.T) {
+	client := setupTestClient(t)
+
+	if err := client.TasksCancel().ParentTask(12).Validate(); err != nil {
+		t.Errorf("expected Validate to succeed; got: %v", err)
+	}
+	if err := client.TasksCancel().ParentTaskId("oTUltX4IQMOUUVeiohTt8A:12").Validate(); err != nil {
+		t.Errorf("expected Validate to succeed; got: %v", err)
+	}
+	if err := client.TasksCancel().ParentTask(12).ParentTaskId("oTUltX4IQMOUUVeiohTt8A:12").Validate(); err == nil {
+		t.Error("expected Validate to fail when both ParentTask and ParentTaskId are set")
+	}
+}
+
+func TestTasksCancelResponse(t *testing.T) {
+	body := `{
+		"nodes": {
+			"oTUltX4IQMOUUVeiohTt8A": {
+				"name": "node-1",
+				"transport_address": "127.0.0.1:9300",
+				"host": "127.0.0.1",
+				"ip": "127.0.0.1:9300",
+				"tasks": {
+					"oTUltX4IQMOUUVeiohTt8A:42": {
+						"node": "oTUltX4IQMOUUVeiohTt8A",
+						"id": 42,
+						"type": "transport",
+						"action": "indices:data/write/reindex",
+						"start_time_in_millis": 1483621262147,
+						"running_time_in_nanos": 11450000,
+						"cancellable": true
+					}
+				}
+			}
+		}
+	}`
+	var res TasksListResponse
+	if err := json.Unmarshal([]byte(body), &res); err != nil {
+		t.Fatal(err)
+	}
+	node := res.Nodes["oTUltX4IQMOUUVeiohTt8A"]
+	if node == nil {
+		t.Fatalf("expected node; got: %v", res.Nodes)
+	}
+	task := node.Tasks["oTUltX4IQMOUUVeiohTt8A:42"]
+	if task == nil {
+		t.Fatalf("expected cancelled task; got: %v", node.Tasks)
+	}
+	if want, have := "indices:data/write/reindex", task.Action; want != have {
+		t.Errorf("want %q; got %q", want, have)
+	}
 }
 
 /*
@@ -40,7 +161,7 @@ func TestTasksCancel(t *testing.T) {
 	if esversion < "2.3.0" {
 		t.Skipf("Elasticsearch %v does not support Tasks Management API yet", esversion)
 	}
-	res, err := client.TasksCancel("1").Do(context.TODO())
+	res, err := client.TasksCancel().TaskId(1).Do(context.TODO())
 	if err != nil {
 		t.Fatal(err)
 	}
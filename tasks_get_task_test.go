@@ -0,0 +1,215 @@
+// Copyright 2012-present Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+)
+
+func TestTasksGetTaskBuildURL(t *testing.T) {
+	client := setupTestClient(t)
+
+	tests := []struct {
+		Service        *TasksGetTaskService
+		ExpectedPath   string
+		ExpectedParams url.Values
+	}{
+		{
+			client.TasksGetTask().TaskId("oTUltX4IQMOUUVeiohTt8A:124"),
+			"/_tasks/oTUltX4IQMOUUVeiohTt8A%3A124",
+			url.Values{},
+		},
+		{
+			client.TasksGetTask().TaskIdFromNodeAndId("oTUltX4IQMOUUVeiohTt8A", 124).WaitForCompletion(true).Timeout("30s"),
+			"/_tasks/oTUltX4IQMOUUVeiohTt8A%3A124",
+			url.Values{"wait_for_completion": []string{"true"}, "timeout": []string{"30s"}},
+		},
+	}
+
+	for i, test := range tests {
+		path, params, err := test.Service.buildURL()
+		if err != nil {
+			t.Errorf("case #%d: %v", i+1, err)
+			continue
+		}
+		if path != test.ExpectedPath {
+			t.Errorf("case #%d: expected %q; got: %q", i+1, test.ExpectedPath, path)
+		}
+		if params.Encode() != test.ExpectedParams.Encode() {
+			t.Errorf("case #%d: expected params %v; got: %v", i+1, test.ExpectedParams, params)
+		}
+	}
+}
+
+func TestTasksGetTaskValidate(t *testing.T) {
+	client := setupTestClient(t)
+
+	if err := client.TasksGetTask().Validate(); err == nil {
+		t.Fatal("expected Validate to fail without task id")
+	}
+}
+
+func TestTasksGetTaskResponse(t *testing.T) {
+	body := `{
+		"completed": true,
+		"task": {
+			"node": "oTUltX4IQMOUUVeiohTt8A",
+			"id": 124,
+			"type": "transport",
+			"action": "indices:data/write/reindex",
+			"status": { "total": 6154, "updated": 3500, "created": 0, "deleted": 0 },
+			"description": "reindex from [twitter] to [twitter2]",
+			"start_time_in_millis": 1483621262147,
+			"running_time_in_nanos": 11450000,
+			"cancellable": true
+		}
+	}`
+	var res TasksGetTaskResponse
+	if err := json.Unmarshal([]byte(body), &res); err != nil {
+		t.Fatal(err)
+	}
+	if !res.Completed {
+		t.Errorf("expected completed = %v; got: %v", true, res.Completed)
+	}
+	if res.Task == nil {
+		t.Fatal("expected task; got: nil")
+	}
+	if want, have := int64(124), res.Task.Id; want != have {
+		t.Errorf("expected id = %d; got: %d", want, have)
+	}
+	if want, have := true, res.Task.Cancellable; want != have {
+		t.Errorf("expected cancellable = %v; got: %v", want, have)
+	}
+}
+
+func TestWaitForTask(t *testing.T) {
+	var calls int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if want, have := "/_tasks/oTUltX4IQMOUUVeiohTt8A:124", r.URL.Path; want != have {
+			t.Errorf("expected path %q; got: %q", want, have)
+		}
+		calls++
+		w.Header().Set("Content-Type", "application/json")
+		if calls < 3 {
+			fmt.Fprint(w, `{"completed":false,"task":{"node":"oTUltX4IQMOUUVeiohTt8A","id":124,"action":"indices:data/write/reindex"}}`)
+			return
+		}
+		fmt.Fprint(w, `{
+			"completed": true,
+			"task": {"node": "oTUltX4IQMOUUVeiohTt8A", "id": 124, "action": "indices:data/write/reindex"},
+			"response": {"took": 120, "timed_out": false, "total": 6154, "created": 6154, "failures": []}
+		}`)
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(SetURL(ts.URL), SetSniff(false), SetHealthcheck(false), SetMaxRetries(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := client.WaitForTask(context.TODO(), "oTUltX4IQMOUUVeiohTt8A:124", 10*time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, have := 3, calls; want != have {
+		t.Errorf("expected %d calls; got: %d", want, have)
+	}
+	if !res.Completed {
+		t.Errorf("expected completed = %v; got: %v", true, res.Completed)
+	}
+	if res.Error != nil {
+		t.Errorf("expected no error; got: %v", res.Error)
+	}
+	var reindex BulkIndexByScrollResponse
+	if err := json.Unmarshal(res.Response, &reindex); err != nil {
+		t.Fatal(err)
+	}
+	if want, have := int64(6154), reindex.Created; want != have {
+		t.Errorf("expected created = %d; got: %d", want, have)
+	}
+}
+
+func TestWaitForTaskWithError(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{
+			"completed": true,
+			"task": {"node": "oTUltX4IQMOUUVeiohTt8A", "id": 124, "action": "indices:data/write/reindex"},
+			"error": {"type": "index_not_found_exception", "reason": "no such index"}
+		}`)
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(SetURL(ts.URL), SetSniff(false), SetHealthcheck(false), SetMaxRetries(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := client.WaitForTask(context.TODO(), "oTUltX4IQMOUUVeiohTt8A:124", 10*time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Error == nil {
+		t.Fatal("expected task error; got: nil")
+	}
+	if want, have := "index_not_found_exception", res.Error.Type; want != have {
+		t.Errorf("expected error type %q; got: %q", want, have)
+	}
+}
+
+func TestWaitForTaskContextDone(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"completed":false,"task":{"node":"oTUltX4IQMOUUVeiohTt8A","id":124}}`)
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(SetURL(ts.URL), SetSniff(false), SetHealthcheck(false), SetMaxRetries(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	res, err := client.WaitForTask(ctx, "oTUltX4IQMOUUVeiohTt8A:124", 10*time.Millisecond)
+	if err == nil {
+		t.Fatal("expected error when context is done")
+	}
+	if res != nil {
+		t.Errorf("expected result to be == nil; got: %v", res)
+	}
+}
+
+func TestWaitForTaskWithInvalidPollInterval(t *testing.T) {
+	var calls int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"completed":false,"task":{"node":"oTUltX4IQMOUUVeiohTt8A","id":124}}`)
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(SetURL(ts.URL), SetSniff(false), SetHealthcheck(false), SetMaxRetries(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, interval := range []time.Duration{0, -time.Second} {
+		res, err := client.WaitForTask(context.TODO(), "oTUltX4IQMOUUVeiohTt8A:124", interval)
+		if err == nil {
+			t.Errorf("expected error with poll interval %v", interval)
+		}
+		if res != nil {
+			t.Errorf("expected result to be == nil; got: %v", res)
+		}
+	}
+	if calls != 0 {
+		t.Errorf("expected no calls; got: %d", calls)
+	}
+}
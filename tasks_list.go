@@ -5,6 +5,7 @@
 package elastic
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/url"
 	"strings"
@@ -28,7 +29,9 @@ type TasksListService struct {
 	nodeId            []string
 	parentNode        string
 	parentTask        *int64
+	parentTaskId      string
 	waitForCompletion *bool
+	groupBy           string
 }
 
 // NewTasksListService creates a new TasksListService.
@@ -79,6 +82,13 @@ func (s *TasksListService) ParentTask(parentTask int64) *TasksListService {
 	return s
 }
 
+// ParentTaskId returns tasks with specified parent task id (node_id:task_number).
+// Set to -1 to return all.
+func (s *TasksListService) ParentTaskId(parentTaskId string) *TasksListService {
+	s.parentTaskId = parentTaskId
+	return s
+}
+
 // WaitForCompletion indicates whether to wait for the matching tasks
 // to complete (default: false).
 func (s *TasksListService) WaitForCompletion(waitForCompletion bool) *TasksListService {
@@ -86,6 +96,15 @@ func (s *TasksListService) WaitForCompletion(waitForCompletion bool) *TasksListS
 	return s
 }
 
+// GroupBy groups tasks by nodes or parent/child relationships.
+// As of now, it can either be "nodes" (default), "parents", or "none".
+// Tasks grouped by parents or not grouped at all are returned in the
+// Tasks field of TasksListResponse instead of the Nodes field.
+func (s *TasksListService) GroupBy(groupBy string) *TasksListService {
+	s.groupBy = groupBy
+	return s
+}
+
 // Pretty indicates that the JSON response be indented and human readable.
 func (s *TasksListService) Pretty(pretty bool) *TasksListService {
 	s.pretty = pretty
@@ -132,9 +151,15 @@ func (s *TasksListService) buildURL() (string, url.Values, error) {
 	if s.parentTask != nil {
 		params.Set("parent_task", fmt.Sprintf("%v", *s.parentTask))
 	}
+	if s.parentTaskId != "" {
+		params.Set("parent_task_id", s.parentTaskId)
+	}
 	if s.waitForCompletion != nil {
 		params.Set("wait_for_completion", fmt.Sprintf("%v", *s.waitForCompletion))
 	}
+	if s.groupBy != "" {
+		params.Set("group_by", s.groupBy)
+	}
 	return path, params, nil
 }
 
@@ -176,6 +201,45 @@ type TasksListResponse struct {
 	NodeFailures []*FailedNodeException  `json:"node_failures"`
 	// Nodes returns the tasks per node. The key is the node id.
 	Nodes map[string]*DiscoveryNode `json:"nodes"`
+	// Tasks returns the tasks when grouped by "parents" or "none".
+	// The key is the task id, e.g. "oTUltX4IQMOUUVeiohTt8A:124".
+	Tasks map[string]*TaskInfo `json:"tasks"`
+}
+
+// UnmarshalJSON decodes JSON data and initializes a TasksListResponse.
+// When tasks are grouped by "none", Elasticsearch returns them as a list;
+// they are keyed by their node and id like for the "parents" grouping.
+func (r *TasksListResponse) UnmarshalJSON(data []byte) error {
+	var aux struct {
+		TaskFailures []*TaskOperationFailure   `json:"task_failures"`
+		NodeFailures []*FailedNodeException    `json:"node_failures"`
+		Nodes        map[string]*DiscoveryNode `json:"nodes"`
+		Tasks        json.RawMessage           `json:"tasks"`
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	r.TaskFailures = aux.TaskFailures
+	r.NodeFailures = aux.NodeFailures
+	r.Nodes = aux.Nodes
+	r.Tasks = nil
+	if len(aux.Tasks) > 0 && aux.Tasks[0] == '[' {
+		var tasks []*TaskInfo
+		if err := json.Unmarshal(aux.Tasks, &tasks); err != nil {
+			return err
+		}
+		r.Tasks = make(map[string]*TaskInfo)
+		for _, task := range tasks {
+			if task != nil {
+				r.Tasks[fmt.Sprintf("%s:%d", task.Node, task.Id)] = task
+			}
+		}
+	} else if len(aux.Tasks) > 0 {
+		if err := json.Unmarshal(aux.Tasks, &r.Tasks); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 type TaskOperationFailure struct {
@@ -212,4 +276,7 @@ type TaskInfo struct {
 	RunningTime        string      `json:"running_time"`
 	RunningTimeInNanos int64       `json:"running_time_in_nanos"`
 	ParentTaskId       string      `json:"parent_task_id"` // like "YxJnVYjwSBm_AUbzddTajQ:12356"
+	Cancellable        bool        `json:"cancellable"`
+	// Children returns the child tasks when grouped by "parents".
+	Children []*TaskInfo `json:"children"`
 }
@@ -5,6 +5,8 @@
 package elastic
 
 import (
+	"encoding/json"
+	"net/url"
 	"testing"
 
 	"golang.org/x/net/context"
@@ -43,6 +45,114 @@ func TestTasksListBuildURL(t *testing.T) {
 	}
 }
 
+func TestTasksListBuildURLParams(t *testing.T) {
+	client := setupTestClient(t)
+
+	tests := []struct {
+		Service  *TasksListService
+		Expected url.Values
+	}{
+		{
+			client.TasksList(),
+			url.Values{},
+		},
+		{
+			client.TasksList().GroupBy("parents").Detailed(true),
+			url.Values{"group_by": []string{"parents"}, "detailed": []string{"true"}},
+		},
+		{
+			client.TasksList().Actions("*reindex", "*byquery").ParentTaskId("oTUltX4IQMOUUVeiohTt8A:123"),
+			url.Values{"actions": []string{"*reindex,*byquery"}, "parent_task_id": []string{"oTUltX4IQMOUUVeiohTt8A:123"}},
+		},
+	}
+
+	for i, test := range tests {
+		_, params, err := test.Service.buildURL()
+		if err != nil {
+			t.Errorf("case #%d: %v", i+1, err)
+			continue
+		}
+		if params.Encode() != test.Expected.Encode() {
+			t.Errorf("case #%d: expected %v; got: %v", i+1, test.Expected, params)
+		}
+	}
+}
+
+func TestTasksListResponseGroupedByParents(t *testing.T) {
+	body := `{
+		"tasks": {
+			"oTUltX4IQMOUUVeiohTt8A:123": {
+				"node": "oTUltX4IQMOUUVeiohTt8A",
+				"id": 123,
+				"type": "transport",
+				"action": "indices:data/write/reindex",
+				"status": { "total": 6154, "updated": 3500, "batches": 4 },
+				"description": "reindex from [twitter] to [twitter2]",
+				"start_time_in_millis": 1483621262147,
+				"running_time_in_nanos": 11450000,
+				"cancellable": true,
+				"children": [{
+					"node": "oTUltX4IQMOUUVeiohTt8A",
+					"id": 124,
+					"type": "direct",
+					"action": "indices:data/write/bulk",
+					"description": "requests[1000], indices[twitter2]",
+					"start_time_in_millis": 1483621262200,
+					"running_time_in_nanos": 1000000,
+					"cancellable": false,
+					"parent_task_id": "oTUltX4IQMOUUVeiohTt8A:123"
+				}]
+			}
+		}
+	}`
+	var res TasksListResponse
+	if err := json.Unmarshal([]byte(body), &res); err != nil {
+		t.Fatal(err)
+	}
+	if want, have := 1, len(res.Tasks); want != have {
+		t.Fatalf("expected %d tasks; got: %d", want, have)
+	}
+	task := res.Tasks["oTUltX4IQMOUUVeiohTt8A:123"]
+	if task == nil {
+		t.Fatalf("expected task %q; got: %v", "oTUltX4IQMOUUVeiohTt8A:123", res.Tasks)
+	}
+	if want, have := "reindex from [twitter] to [twitter2]", task.Description; want != have {
+		t.Errorf("expected description = %q; got: %q", want, have)
+	}
+	status, ok := task.Status.(map[string]interface{})
+	if !ok {
+		t.Fatalf("expected status to be an object; got: %T", task.Status)
+	}
+	if want, have := float64(3500), status["updated"]; want != have {
+		t.Errorf("expected status.updated = %v; got: %v", want, have)
+	}
+	if want, have := 1, len(task.Children); want != have {
+		t.Fatalf("expected %d children; got: %d", want, have)
+	}
+	if want, have := "oTUltX4IQMOUUVeiohTt8A:123", task.Children[0].ParentTaskId; want != have {
+		t.Errorf("expected parent_task_id = %q; got: %q", want, have)
+	}
+}
+
+func TestTasksListResponseGroupedByNone(t *testing.T) {
+	body := `{
+		"tasks": [
+			{ "node": "node1", "id": 1, "action": "cluster:monitor/tasks/lists" },
+			{ "node": "node2", "id": 7, "action": "cluster:monitor/tasks/lists[n]" }
+		]
+	}`
+	var res TasksListResponse
+	if err := json.Unmarshal([]byte(body), &res); err != nil {
+		t.Fatal(err)
+	}
+	if want, have := 2, len(res.Tasks); want != have {
+		t.Fatalf("expected %d tasks; got: %d", want, have)
+	}
+	if task := res.Tasks["node2:7"]; task == nil {
+		t.Errorf("expected task %q; got: %v", "node2:7", res.Tasks)
+	}
+}
+
 func TestTasksList(t *testing.T) {
 	client := setupTestClientAndCreateIndexAndAddDocs(t) //, SetTraceLog(log.New(os.Stdout, "", 0)))
 	esversion, err := client.ElasticsearchVersion(DefaultURL)
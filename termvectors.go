@@ -59,7 +59,8 @@ func (s *TermvectorsService) Index(index string) *TermvectorsService {
 	return s
 }
 
-// Type of the document.
+// Type of the document. If it is empty, the typeless endpoints
+// /{index}/_termvectors and /{index}/_termvectors/{id} are used.
 func (s *TermvectorsService) Type(typ string) *TermvectorsService {
 	s.typ = typ
 	return s
@@ -204,11 +205,17 @@ func (s *TermvectorsService) buildURL() (string, url.Values, error) {
 	var err error
 
 	// Build URL
-	if s.id != "" {
+	switch {
+	case s.typ != "" && s.id != "":
 		pathParam["id"] = s.id
 		path, err = uritemplates.Expand("/{index}/{type}/{id}/_termvectors", pathParam)
-	} else {
+	case s.typ != "":
 		path, err = uritemplates.Expand("/{index}/{type}/_termvectors", pathParam)
+	case s.id != "":
+		pathParam["id"] = s.id
+		path, err = uritemplates.Expand("/{index}/_termvectors/{id}", pathParam)
+	default:
+		path, err = uritemplates.Expand("/{index}/_termvectors", pathParam)
 	}
 
 	if err != nil {
@@ -268,9 +275,6 @@ func (s *TermvectorsService) Validate() error {
 	if s.index == "" {
 		invalid = append(invalid, "Index")
 	}
-	if s.typ == "" {
-		invalid = append(invalid, "Type")
-	}
 	if len(invalid) > 0 {
 		return fmt.Errorf("missing required fields: %v", invalid)
 	}
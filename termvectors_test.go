@@ -6,6 +6,10 @@ package elastic
 
 import (
 	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 	"time"
 )
@@ -31,6 +35,18 @@ func TestTermVectorsBuildURL(t *testing.T) {
 			"1",
 			"/twitter/tweet/1/_termvectors",
 		},
+		{
+			"twitter",
+			"",
+			"",
+			"/twitter/_termvectors",
+		},
+		{
+			"twitter",
+			"",
+			"1",
+			"/twitter/_termvectors/1",
+		},
 	}
 
 	for _, test := range tests {
@@ -155,3 +171,159 @@ func TestTermVectorsWithFilter(t *testing.T) {
 		t.Errorf("expected found to be %v; got: %v", true, result.Found)
 	}
 }
+
+const testTermVectorsResponse = `{
+	"_index": "twitter",
+	"_type": "tweet",
+	"_id": "1",
+	"_version": 1,
+	"found": true,
+	"took": 2,
+	"term_vectors": {
+		"message": {
+			"field_statistics": {
+				"sum_doc_freq": 6,
+				"doc_count": 2,
+				"sum_ttf": 8
+			},
+			"terms": {
+				"test": {
+					"doc_freq": 2,
+					"ttf": 4,
+					"term_freq": 3,
+					"tokens": [
+						{"position": 1, "start_offset": 8, "end_offset": 12},
+						{"position": 2, "start_offset": 13, "end_offset": 17},
+						{"position": 3, "start_offset": 18, "end_offset": 22}
+					]
+				}
+			}
+		}
+	}
+}`
+
+func TestTermVectorsDecodeRealtime(t *testing.T) {
+	var path, query, body string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			t.Error(err)
+		}
+		path, query, body = r.URL.Path, r.URL.RawQuery, string(data)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, testTermVectorsResponse)
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(SetURL(ts.URL), SetSniff(false), SetHealthcheck(false), SetMaxRetries(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := client.TermVectors("twitter", "").
+		Id("1").
+		Fields("message").
+		Offsets(true).
+		Positions(true).
+		TermStatistics(true).
+		FieldStatistics(true).
+		Do(context.TODO())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, have := "/twitter/_termvectors/1", path; want != have {
+		t.Errorf("expected path %q; got: %q", want, have)
+	}
+	if want, have := "field_statistics=true&fields=message&offsets=true&positions=true&term_statistics=true", query; want != have {
+		t.Errorf("expected query %q; got: %q", want, have)
+	}
+	if body != "" {
+		t.Errorf("expected no body; got: %s", body)
+	}
+	if !res.Found {
+		t.Fatal("expected found to be true")
+	}
+	field, ok := res.TermVectors["message"]
+	if !ok {
+		t.Fatal("expected term vectors for field message")
+	}
+	if want, have := int64(2), field.FieldStatistics.DocCount; want != have {
+		t.Errorf("expected doc_count %d; got: %d", want, have)
+	}
+	if want, have := int64(6), field.FieldStatistics.SumDocFreq; want != have {
+		t.Errorf("expected sum_doc_freq %d; got: %d", want, have)
+	}
+	if want, have := int64(8), field.FieldStatistics.SumTtf; want != have {
+		t.Errorf("expected sum_ttf %d; got: %d", want, have)
+	}
+	term, ok := field.Terms["test"]
+	if !ok {
+		t.Fatal("expected term test")
+	}
+	if want, have := int64(3), term.TermFreq; want != have {
+		t.Errorf("expected term_freq %d; got: %d", want, have)
+	}
+	if want, have := int64(2), term.DocFreq; want != have {
+		t.Errorf("expected doc_freq %d; got: %d", want, have)
+	}
+	if want, have := int64(4), term.Ttf; want != have {
+		t.Errorf("expected ttf %d; got: %d", want, have)
+	}
+	if want, have := 3, len(term.Tokens); want != have {
+		t.Fatalf("expected %d tokens; got: %d", want, have)
+	}
+	if want, have := int64(13), term.Tokens[1].StartOffset; want != have {
+		t.Errorf("expected start_offset %d; got: %d", want, have)
+	}
+}
+
+func TestTermVectorsDecodeArtificial(t *testing.T) {
+	var path, body string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			t.Error(err)
+		}
+		path, body = r.URL.Path, string(data)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{
+			"_index": "twitter",
+			"_type": "tweet",
+			"_version": 0,
+			"found": true,
+			"took": 1,
+			"term_vectors": {
+				"message": {
+					"field_statistics": {"sum_doc_freq": 6, "doc_count": 2, "sum_ttf": 8},
+					"terms": {
+						"test": {"doc_freq": 2, "ttf": 4, "term_freq": 2}
+					}
+				}
+			}
+		}`)
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(SetURL(ts.URL), SetSniff(false), SetHealthcheck(false), SetMaxRetries(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := client.TermVectors("twitter", "").
+		Doc(map[string]interface{}{"message": "test test"}).
+		TermStatistics(true).
+		Do(context.TODO())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, have := "/twitter/_termvectors", path; want != have {
+		t.Errorf("expected path %q; got: %q", want, have)
+	}
+	if want, have := `{"doc":{"message":"test test"}}`, body; want != have {
+		t.Errorf("expected body %s; got: %s", want, have)
+	}
+	if res.Id != "" {
+		t.Errorf("expected no id for an artificial document; got: %q", res.Id)
+	}
+	if want, have := int64(2), res.TermVectors["message"].Terms["test"].TermFreq; want != have {
+		t.Errorf("expected term_freq %d; got: %d", want, have)
+	}
+}
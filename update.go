@@ -36,6 +36,7 @@ type UpdateService struct {
 	docAsUpsert         *bool
 	detectNoop          *bool
 	doc                 interface{}
+	fsc                 *FetchSourceContext
 	timeout             string
 	pretty              bool
 }
@@ -111,7 +112,11 @@ func (b *UpdateService) VersionType(versionType string) *UpdateService {
 	return b
 }
 
-// Refresh the index after performing the update.
+// Refresh controls when changes made by this request are made visible
+// to search. The allowed values are: "true" (refresh the relevant
+// primary and replica shards immediately), "wait_for" (wait for the
+// changes to be made visible by a refresh before applying), or "false"
+// (no refresh related actions).
 func (b *UpdateService) Refresh(refresh string) *UpdateService {
 	b.refresh = refresh
 	return b
@@ -161,6 +166,23 @@ func (b *UpdateService) ScriptedUpsert(scriptedUpsert bool) *UpdateService {
 	return b
 }
 
+// FetchSource asks Elasticsearch to return the updated _source in the response.
+func (b *UpdateService) FetchSource(fetchSource bool) *UpdateService {
+	if b.fsc == nil {
+		b.fsc = NewFetchSourceContext(fetchSource)
+	} else {
+		b.fsc.SetFetchSource(fetchSource)
+	}
+	return b
+}
+
+// FetchSourceContext indicates whether and which fields of the updated
+// _source should be returned in the response.
+func (b *UpdateService) FetchSourceContext(fetchSourceContext *FetchSourceContext) *UpdateService {
+	b.fsc = fetchSourceContext
+	return b
+}
+
 // Timeout is an explicit timeout for the operation, e.g. "1000", "1s" or "500ms".
 func (b *UpdateService) Timeout(timeout string) *UpdateService {
 	b.timeout = timeout
@@ -251,12 +273,29 @@ func (b *UpdateService) body() (interface{}, error) {
 	if b.detectNoop != nil {
 		source["detect_noop"] = *b.detectNoop
 	}
+	if b.fsc != nil {
+		src, err := b.fsc.Source()
+		if err != nil {
+			return nil, err
+		}
+		source["_source"] = src
+	}
 
 	return source, nil
 }
 
+// Validate checks if the operation is valid.
+func (b *UpdateService) Validate() error {
+	return validateRefresh(b.refresh)
+}
+
 // Do executes the update operation.
 func (b *UpdateService) Do(ctx context.Context) (*UpdateResponse, error) {
+	// Check pre-conditions
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+
 	path, params, err := b.url()
 	if err != nil {
 		return nil, err
@@ -8,6 +8,8 @@ import (
 	"encoding/json"
 	"net/url"
 	"testing"
+
+	"golang.org/x/net/context"
 )
 
 func TestUpdateViaScript(t *testing.T) {
@@ -231,3 +233,98 @@ func TestUpdateViaDocAndUpsert(t *testing.T) {
 		t.Errorf("expected\n%s\ngot:\n%s", expected, got)
 	}
 }
+
+func TestUpdateViaScriptedUpsert(t *testing.T) {
+	client := setupTestClient(t)
+	update := client.Update().
+		Index("test").Type("type1").Id("1").
+		Script(NewScript("ctx._source.counter += count").Params(map[string]interface{}{"count": 4})).
+		ScriptedUpsert(true).
+		Upsert(map[string]interface{}{})
+	body, err := update.body()
+	if err != nil {
+		t.Fatalf("expected to return body, got: %v", err)
+	}
+	data, err := json.Marshal(body)
+	if err != nil {
+		t.Fatalf("expected to marshal body as JSON, got: %v", err)
+	}
+	got := string(data)
+	expected := `{"script":{"inline":"ctx._source.counter += count","params":{"count":4}},"scripted_upsert":true,"upsert":{}}`
+	if got != expected {
+		t.Errorf("expected\n%s\ngot:\n%s", expected, got)
+	}
+}
+
+func TestUpdateViaDocWithoutDetectNoop(t *testing.T) {
+	client := setupTestClient(t)
+	update := client.Update().
+		Index("test").Type("type1").Id("1").
+		Doc(map[string]interface{}{"name": "new_name"}).
+		DetectNoop(false)
+	body, err := update.body()
+	if err != nil {
+		t.Fatalf("expected to return body, got: %v", err)
+	}
+	data, err := json.Marshal(body)
+	if err != nil {
+		t.Fatalf("expected to marshal body as JSON, got: %v", err)
+	}
+	got := string(data)
+	expected := `{"detect_noop":false,"doc":{"name":"new_name"}}`
+	if got != expected {
+		t.Errorf("expected\n%s\ngot:\n%s", expected, got)
+	}
+}
+
+func TestUpdateWithFetchSourceContext(t *testing.T) {
+	client := setupTestClient(t)
+	update := client.Update().
+		Index("test").Type("type1").Id("1").
+		Doc(map[string]interface{}{"name": "new_name"}).
+		FetchSourceContext(NewFetchSourceContext(true).Include("name", "counter"))
+	body, err := update.body()
+	if err != nil {
+		t.Fatalf("expected to return body, got: %v", err)
+	}
+	data, err := json.Marshal(body)
+	if err != nil {
+		t.Fatalf("expected to marshal body as JSON, got: %v", err)
+	}
+	got := string(data)
+	expected := `{"_source":{"excludes":[],"includes":["name","counter"]},"doc":{"name":"new_name"}}`
+	if got != expected {
+		t.Errorf("expected\n%s\ngot:\n%s", expected, got)
+	}
+}
+
+func TestUpdateRefresh(t *testing.T) {
+	client := setupTestClient(t)
+
+	update := client.Update().
+		Index("test").Type("type1").Id("1").
+		Doc(map[string]interface{}{"name": "new_name"}).
+		Refresh("wait_for")
+	if err := update.Validate(); err != nil {
+		t.Fatalf("expected Update to accept refresh=wait_for; got: %v", err)
+	}
+	_, params, err := update.url()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, have := "wait_for", params.Get("refresh"); want != have {
+		t.Errorf("expected refresh=%q; got: %q", want, have)
+	}
+
+	res, err := client.Update().
+		Index("test").Type("type1").Id("1").
+		Doc(map[string]interface{}{"name": "new_name"}).
+		Refresh("yes").
+		Do(context.TODO())
+	if err == nil {
+		t.Fatalf("expected Update to fail with invalid refresh value")
+	}
+	if res != nil {
+		t.Fatalf("expected result to be == nil; got: %v", res)
+	}
+}
@@ -0,0 +1,131 @@
+// Copyright 2012-present Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+import (
+	"encoding/json"
+	"net/url"
+	"testing"
+)
+
+func TestValidateBuildURL(t *testing.T) {
+	client := setupTestClient(t)
+
+	tests := []struct {
+		Service        *ValidateService
+		ExpectedPath   string
+		ExpectedParams url.Values
+	}{
+		{
+			client.Validate(),
+			"/_validate/query",
+			url.Values{},
+		},
+		{
+			client.Validate("twitter", "facebook"),
+			"/twitter%2Cfacebook/_validate/query",
+			url.Values{},
+		},
+		{
+			client.Validate("twitter").Type("tweet"),
+			"/twitter/tweet/_validate/query",
+			url.Values{},
+		},
+		{
+			client.Validate("twitter").Explain(true).Rewrite(true).AllShards(true),
+			"/twitter/_validate/query",
+			url.Values{"explain": []string{"true"}, "rewrite": []string{"true"}, "all_shards": []string{"true"}},
+		},
+	}
+
+	for i, test := range tests {
+		path, params, err := test.Service.buildURL()
+		if err != nil {
+			t.Errorf("case #%d: %v", i+1, err)
+			continue
+		}
+		if path != test.ExpectedPath {
+			t.Errorf("case #%d: expected %q; got: %q", i+1, test.ExpectedPath, path)
+		}
+		if params.Encode() != test.ExpectedParams.Encode() {
+			t.Errorf("case #%d: expected params %v; got: %v", i+1, test.ExpectedParams, params)
+		}
+	}
+}
+
+func TestValidateBody(t *testing.T) {
+	client := setupTestClient(t)
+
+	svc := client.Validate("twitter").Query(NewTermQuery("user", "olivere"))
+	data, err := json.Marshal(svc.getBody())
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"query":{"term":{"user":"olivere"}}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
+
+func TestValidateResponseValidQuery(t *testing.T) {
+	body := `{
+		"valid": true,
+		"_shards": { "total": 1, "successful": 1, "failed": 0 },
+		"explanations": [{
+			"index": "twitter",
+			"valid": true,
+			"explanation": "+user:olivere #(#_type:tweet)"
+		}]
+	}`
+	var res ValidateResponse
+	if err := json.Unmarshal([]byte(body), &res); err != nil {
+		t.Fatal(err)
+	}
+	if !res.Valid {
+		t.Errorf("expected valid = %v; got: %v", true, res.Valid)
+	}
+	if res.Shards == nil || res.Shards.Successful != 1 {
+		t.Errorf("expected 1 successful shard; got: %+v", res.Shards)
+	}
+	if want, have := 1, len(res.Explanations); want != have {
+		t.Fatalf("expected %d explanations; got: %d", want, have)
+	}
+	if want, have := "+user:olivere #(#_type:tweet)", res.Explanations[0].Explanation; want != have {
+		t.Errorf("expected explanation = %q; got: %q", want, have)
+	}
+}
+
+func TestValidateResponseInvalidQuery(t *testing.T) {
+	body := `{
+		"valid": false,
+		"_shards": { "total": 1, "successful": 1, "failed": 0 },
+		"explanations": [{
+			"index": "twitter",
+			"valid": false,
+			"error": "twitter/IAEc2nIXSSunQA_suI0MLw] QueryShardException[failed to create query: {...}]; nested: NumberFormatException[For input string: \"foo\"];"
+		}]
+	}`
+	var res ValidateResponse
+	if err := json.Unmarshal([]byte(body), &res); err != nil {
+		t.Fatal(err)
+	}
+	if res.Valid {
+		t.Errorf("expected valid = %v; got: %v", false, res.Valid)
+	}
+	if want, have := 1, len(res.Explanations); want != have {
+		t.Fatalf("expected %d explanations; got: %d", want, have)
+	}
+	expl := res.Explanations[0]
+	if expl.Valid {
+		t.Errorf("expected explanation valid = %v; got: %v", false, expl.Valid)
+	}
+	if expl.Error == "" {
+		t.Errorf("expected an error; got: %q", expl.Error)
+	}
+	if want, have := "twitter", expl.Index; want != have {
+		t.Errorf("expected index = %q; got: %q", want, have)
+	}
+}